@@ -25,6 +25,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArgoCDTarget) DeepCopyInto(out *ArgoCDTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArgoCDTarget.
+func (in *ArgoCDTarget) DeepCopy() *ArgoCDTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ArgoCDTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSet) DeepCopyInto(out *CertificateSet) {
 	*out = *in
@@ -93,6 +108,171 @@ func (in *CertificateSetSpec) DeepCopyInto(out *CertificateSetSpec) {
 		*out = new(IssuerReference)
 		**out = **in
 	}
+	if in.GenerateETCD != nil {
+		in, out := &in.GenerateETCD, &out.GenerateETCD
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ETCDDNSNames != nil {
+		in, out := &in.ETCDDNSNames, &out.ETCDDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ETCDIPAddresses != nil {
+		in, out := &in.ETCDIPAddresses, &out.ETCDIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GenerateProxy != nil {
+		in, out := &in.GenerateProxy, &out.GenerateProxy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ArgoCDTargets != nil {
+		in, out := &in.ArgoCDTargets, &out.ArgoCDTargets
+		*out = make([]ArgoCDTarget, len(*in))
+		copy(*out, *in)
+	}
+	if in.ArgoCDClusterLabels != nil {
+		in, out := &in.ArgoCDClusterLabels, &out.ArgoCDClusterLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KubeconfigMirrorNamespaces != nil {
+		in, out := &in.KubeconfigMirrorNamespaces, &out.KubeconfigMirrorNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeconfigExec != nil {
+		in, out := &in.KubeconfigExec, &out.KubeconfigExec
+		*out = new(KubeconfigExec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+	if in.KubeconfigTemplateRef != nil {
+		in, out := &in.KubeconfigTemplateRef, &out.KubeconfigTemplateRef
+		*out = new(ConfigMapKeyReference)
+		**out = **in
+	}
+	if in.Pkcs12PasswordSecretRef != nil {
+		in, out := &in.Pkcs12PasswordSecretRef, &out.Pkcs12PasswordSecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+	if in.JksPasswordSecretRef != nil {
+		in, out := &in.JksPasswordSecretRef, &out.JksPasswordSecretRef
+		*out = new(SecretKeyReference)
+		**out = **in
+	}
+	if in.PropagateLabels != nil {
+		in, out := &in.PropagateLabels, &out.PropagateLabels
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretLabels != nil {
+		in, out := &in.SecretLabels, &out.SecretLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretAnnotations != nil {
+		in, out := &in.SecretAnnotations, &out.SecretAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CertificateSecretAnnotations != nil {
+		in, out := &in.CertificateSecretAnnotations, &out.CertificateSecretAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CADuration != nil {
+		in, out := &in.CADuration, &out.CADuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ExistingCASecretRef != nil {
+		in, out := &in.ExistingCASecretRef, &out.ExistingCASecretRef
+		*out = new(SecretReference)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClientCertRenewBefore != nil {
+		in, out := &in.ClientCertRenewBefore, &out.ClientCertRenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ClientCertDuration != nil {
+		in, out := &in.ClientCertDuration, &out.ClientCertDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OIDCDuration != nil {
+		in, out := &in.OIDCDuration, &out.OIDCDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OIDCRenewBefore != nil {
+		in, out := &in.OIDCRenewBefore, &out.OIDCRenewBefore
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.OIDCDNSNames != nil {
+		in, out := &in.OIDCDNSNames, &out.OIDCDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientOrganizations != nil {
+		in, out := &in.ClientOrganizations, &out.ClientOrganizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientDNSNames != nil {
+		in, out := &in.ClientDNSNames, &out.ClientDNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientIPAddresses != nil {
+		in, out := &in.ClientIPAddresses, &out.ClientIPAddresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CAUsages != nil {
+		in, out := &in.CAUsages, &out.CAUsages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(CertificateSubject)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCertificates != nil {
+		in, out := &in.ClientCertificates, &out.ClientCertificates
+		*out = make([]ClientCertSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KeySizes != nil {
+		in, out := &in.KeySizes, &out.KeySizes
+		*out = new(KeySizes)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSetSpec.
@@ -115,6 +295,33 @@ func (in *CertificateSetStatus) DeepCopyInto(out *CertificateSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.GeneratedSecrets != nil {
+		in, out := &in.GeneratedSecrets, &out.GeneratedSecrets
+		*out = make([]GeneratedSecret, len(*in))
+		copy(*out, *in)
+	}
+	if in.PlannedResources != nil {
+		in, out := &in.PlannedResources, &out.PlannedResources
+		*out = make([]PlannedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.CAExpiry != nil {
+		in, out := &in.CAExpiry, &out.CAExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.ClientExpiry != nil {
+		in, out := &in.ClientExpiry, &out.ClientExpiry
+		*out = (*in).DeepCopy()
+	}
+	if in.NextClientRenewal != nil {
+		in, out := &in.NextClientRenewal, &out.NextClientRenewal
+		*out = (*in).DeepCopy()
+	}
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSetStatus.
@@ -127,6 +334,96 @@ func (in *CertificateSetStatus) DeepCopy() *CertificateSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateSubject) DeepCopyInto(out *CertificateSubject) {
+	*out = *in
+	if in.Countries != nil {
+		in, out := &in.Countries, &out.Countries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OrganizationalUnits != nil {
+		in, out := &in.OrganizationalUnits, &out.OrganizationalUnits
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Localities != nil {
+		in, out := &in.Localities, &out.Localities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provinces != nil {
+		in, out := &in.Provinces, &out.Provinces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSubject.
+func (in *CertificateSubject) DeepCopy() *CertificateSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertSpec) DeepCopyInto(out *ClientCertSpec) {
+	*out = *in
+	if in.Organizations != nil {
+		in, out := &in.Organizations, &out.Organizations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertSpec.
+func (in *ClientCertSpec) DeepCopy() *ClientCertSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GeneratedSecret) DeepCopyInto(out *GeneratedSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GeneratedSecret.
+func (in *GeneratedSecret) DeepCopy() *GeneratedSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(GeneratedSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IssuerReference) DeepCopyInto(out *IssuerReference) {
 	*out = *in
@@ -141,3 +438,83 @@ func (in *IssuerReference) DeepCopy() *IssuerReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeySizes) DeepCopyInto(out *KeySizes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeySizes.
+func (in *KeySizes) DeepCopy() *KeySizes {
+	if in == nil {
+		return nil
+	}
+	out := new(KeySizes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigExec) DeepCopyInto(out *KubeconfigExec) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigExec.
+func (in *KubeconfigExec) DeepCopy() *KubeconfigExec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlannedResource) DeepCopyInto(out *PlannedResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlannedResource.
+func (in *PlannedResource) DeepCopy() *PlannedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(PlannedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeyReference) DeepCopyInto(out *SecretKeyReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeyReference.
+func (in *SecretKeyReference) DeepCopy() *SecretKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretReference) DeepCopyInto(out *SecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretReference.
+func (in *SecretReference) DeepCopy() *SecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
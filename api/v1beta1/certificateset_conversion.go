@@ -0,0 +1,76 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+var _ conversion.Convertible = &CertificateSet{}
+
+// ConvertTo converts this CertificateSet to the hub version (v1alpha1).
+func (src *CertificateSet) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*incloudiov1alpha1.CertificateSet)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha1 CertificateSet but got %T", dstRaw)
+	}
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertJSON(src.Spec, &dst.Spec); err != nil {
+		return fmt.Errorf("failed to convert spec: %w", err)
+	}
+	if err := convertJSON(src.Status, &dst.Status); err != nil {
+		return fmt.Errorf("failed to convert status: %w", err)
+	}
+	return nil
+}
+
+// ConvertFrom converts the hub version (v1alpha1) to this CertificateSet.
+func (dst *CertificateSet) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*incloudiov1alpha1.CertificateSet)
+	if !ok {
+		return fmt.Errorf("expected a v1alpha1 CertificateSet but got %T", srcRaw)
+	}
+	dst.ObjectMeta = src.ObjectMeta
+	if err := convertJSON(src.Spec, &dst.Spec); err != nil {
+		return fmt.Errorf("failed to convert spec: %w", err)
+	}
+	if err := convertJSON(src.Status, &dst.Status); err != nil {
+		return fmt.Errorf("failed to convert status: %w", err)
+	}
+	return nil
+}
+
+// convertJSON copies src into dst through their JSON form. v1beta1 is spec-compatible with v1alpha1,
+// so every field maps onto the field with the same JSON name; renamed fields have to be converted
+// explicitly once the versions diverge.
+func convertJSON[T any](src any, dst *T) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+	var out T
+	if err := json.Unmarshal(data, &out); err != nil {
+		return err
+	}
+	*dst = out
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+func TestConversionRoundTrip(t *testing.T) {
+	generateETCD := false
+	src := &CertificateSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", Labels: map[string]string{"team": "platform"}},
+		Spec: CertificateSetSpec{
+			Environment:        EnvironmentSystem,
+			IssuerRef:          IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+			GenerateETCD:       &generateETCD,
+			KubeconfigEndpoint: "https://api.example.com:6443",
+			ClientCertificates: []ClientCertSpec{{Name: "ci"}},
+			OIDCDNSNames:       []string{"oidc.example.com"},
+		},
+		Status: CertificateSetStatus{Phase: PhaseReady, ObservedGeneration: 3},
+	}
+
+	hub := &incloudiov1alpha1.CertificateSet{}
+	if err := src.ConvertTo(hub); err != nil {
+		t.Fatalf("ConvertTo: %v", err)
+	}
+	if hub.Spec.KubeconfigEndpoint != src.Spec.KubeconfigEndpoint || hub.Status.ObservedGeneration != 3 {
+		t.Fatalf("ConvertTo lost fields: %+v", hub)
+	}
+
+	dst := &CertificateSet{}
+	if err := dst.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom: %v", err)
+	}
+	if !equality.Semantic.DeepEqual(src, dst) {
+		t.Fatalf("round trip changed the object:\nwant %+v\ngot  %+v", src, dst)
+	}
+}
+
+// TestVersionsAreSpecCompatible guards the JSON-based conversion: a field added to one version only
+// would be dropped silently.
+func TestVersionsAreSpecCompatible(t *testing.T) {
+	for _, pair := range [][2]reflect.Type{
+		{reflect.TypeFor[CertificateSetSpec](), reflect.TypeFor[incloudiov1alpha1.CertificateSetSpec]()},
+		{reflect.TypeFor[CertificateSetStatus](), reflect.TypeFor[incloudiov1alpha1.CertificateSetStatus]()},
+	} {
+		got, want := jsonFields(pair[0], ""), jsonFields(pair[1], "")
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s fields differ between v1beta1 and v1alpha1:\nv1beta1:  %v\nv1alpha1: %v", pair[0].Name(), got, want)
+		}
+	}
+}
+
+// jsonFields lists the JSON paths of t with their kinds, following nested structs of this package
+func jsonFields(t reflect.Type, prefix string) []string {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || !strings.HasPrefix(t.PkgPath(), "certificate-set/api/") {
+		return nil
+	}
+	var fields []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		path := prefix + "." + name
+		fields = append(fields, path+":"+f.Type.Kind().String())
+		fields = append(fields, jsonFields(f.Type, path)...)
+	}
+	return fields
+}
@@ -0,0 +1,860 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EnvironmentType defines the type of certificate set to generate
+// +kubebuilder:validation:Enum=client;system;infra
+type EnvironmentType string
+
+const (
+	// EnvironmentClient generates only super-admin certificate
+	EnvironmentClient EnvironmentType = "client"
+	// EnvironmentSystem generates full certificate set with self-signed OIDC
+	EnvironmentSystem EnvironmentType = "system"
+	// EnvironmentInfra generates full certificate set with external OIDC issuer
+	EnvironmentInfra EnvironmentType = "infra"
+)
+
+// PrivateKeyAlgorithm defines the private key algorithm for generated certificates
+// +kubebuilder:validation:Enum=rsa;ecdsa
+type PrivateKeyAlgorithm string
+
+const (
+	// PrivateKeyAlgorithmRSA generates RSA private keys
+	PrivateKeyAlgorithmRSA PrivateKeyAlgorithm = "rsa"
+	// PrivateKeyAlgorithmECDSA generates ECDSA private keys
+	PrivateKeyAlgorithmECDSA PrivateKeyAlgorithm = "ecdsa"
+)
+
+// PrivateKeyEncoding defines the encoding of private keys in the issued Secrets
+// +kubebuilder:validation:Enum=PKCS1;PKCS8
+type PrivateKeyEncoding string
+
+const (
+	// PrivateKeyEncodingPKCS1 encodes RSA keys as PKCS#1 and ECDSA keys as SEC 1
+	PrivateKeyEncodingPKCS1 PrivateKeyEncoding = "PKCS1"
+	// PrivateKeyEncodingPKCS8 encodes keys as PKCS#8
+	PrivateKeyEncodingPKCS8 PrivateKeyEncoding = "PKCS8"
+)
+
+// IssuerScope defines which kind of cert-manager issuer is created from the CA
+// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+type IssuerScope string
+
+const (
+	// IssuerScopeIssuer creates a namespaced Issuer
+	IssuerScopeIssuer IssuerScope = "Issuer"
+	// IssuerScopeClusterIssuer creates a ClusterIssuer
+	IssuerScopeClusterIssuer IssuerScope = "ClusterIssuer"
+)
+
+// CARotationPolicy defines whether cert-manager generates a new CA private key on each re-issuance
+// +kubebuilder:validation:Enum=Never;Always
+type CARotationPolicy string
+
+const (
+	// CARotationPolicyNever keeps the CA private key across re-issuance
+	CARotationPolicyNever CARotationPolicy = "Never"
+	// CARotationPolicyAlways generates a new CA private key on each re-issuance,
+	// which invalidates every certificate signed by the previous key
+	CARotationPolicyAlways CARotationPolicy = "Always"
+)
+
+// KubeconfigAuthMode defines how the kubeconfig user authenticates to the API server
+// +kubebuilder:validation:Enum=clientcert;token;exec
+type KubeconfigAuthMode string
+
+const (
+	// KubeconfigAuthModeClientCert embeds the super-admin client certificate and key
+	KubeconfigAuthModeClientCert KubeconfigAuthMode = "clientcert"
+	// KubeconfigAuthModeToken embeds a bearer token read from a referenced Secret
+	KubeconfigAuthModeToken KubeconfigAuthMode = "token"
+	// KubeconfigAuthModeExec runs a client-go credential plugin that reads the super-admin Secret,
+	// so the kubeconfig keeps working after the certificate is re-issued
+	KubeconfigAuthModeExec KubeconfigAuthMode = "exec"
+)
+
+// ClientCertSpec describes an additional client certificate signed by the CA Issuer
+// +kubebuilder:validation:XValidation:rule="!(self.name in ['ca', 'etcd', 'proxy', 'ca-oidc', 'super-admin', 'kubeconfig', 'argocd-cluster', 'ca-bundle', 'ca-jks', 'etcd-server', 'etcd-peer', 'front-proxy-client', 'cluster-info', 'fullchain', 'etcd-ca-bundle', 'proxy-ca-bundle', 'ca-oidc-bundle', 'ca-cert']) && !self.name.endsWith('-kubeconfig')",message="name collides with a reserved CertificateSet resource name"
+type ClientCertSpec struct {
+	// Name is appended to the CertificateSet name to form the Certificate and Secret name (${name}-${clientName})
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=40
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// Organizations are the subject organizations, mapped to Kubernetes RBAC groups
+	// +optional
+	Organizations []string `json:"organizations,omitempty"`
+
+	// Usages are the cert-manager key usages. Defaults to client auth, data encipherment and key encipherment.
+	// +optional
+	Usages []string `json:"usages,omitempty"`
+}
+
+// ArgoCDTarget is an ArgoCD instance that receives a copy of the ArgoCD cluster Secret
+type ArgoCDTarget struct {
+	// Namespace is the namespace of the ArgoCD instance
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Namespace string `json:"namespace"`
+
+	// NamePrefix is prepended to the Secret name (${namePrefix}${name}-argocd-cluster)
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*)?$`
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+}
+
+// CertificateSetSpec defines the desired state of CertificateSet
+// +kubebuilder:validation:XValidation:rule="!has(self.privateKeySize) || ((!has(self.privateKeyAlgorithm) || self.privateKeyAlgorithm == 'rsa') ? self.privateKeySize in [2048, 3072, 4096] : self.privateKeySize in [256, 384, 521])",message="privateKeySize must be 2048, 3072 or 4096 for rsa and 256, 384 or 521 for ecdsa"
+// +kubebuilder:validation:XValidation:rule="!has(self.clientCertificates) || size(self.clientCertificates) == 0 || (has(self.kubeconfigEndpoint) && self.kubeconfigEndpoint != '')",message="kubeconfigEndpoint is required when clientCertificates are set"
+// +kubebuilder:validation:XValidation:rule="!has(self.keySizes) || ((!has(self.privateKeyAlgorithm) || self.privateKeyAlgorithm == 'rsa') ? ((!has(self.keySizes.ca) || self.keySizes.ca in [2048, 3072, 4096]) && (!has(self.keySizes.leaf) || self.keySizes.leaf in [2048, 3072, 4096])) : ((!has(self.keySizes.ca) || self.keySizes.ca in [256, 384, 521]) && (!has(self.keySizes.leaf) || self.keySizes.leaf in [256, 384, 521])))",message="keySizes must be 2048, 3072 or 4096 for rsa and 256, 384 or 521 for ecdsa"
+// +kubebuilder:validation:XValidation:rule="(has(self.caDuration) ? duration(self.caDuration) : duration('175200h')) > (has(self.renewBefore) ? duration(self.renewBefore) : duration('720h'))",message="renewBefore must be shorter than caDuration (default 175200h)"
+// +kubebuilder:validation:XValidation:rule="!has(self.clientCertRenewBefore) && !has(self.renewBefore) || (has(self.clientCertRenewBefore) ? duration(self.clientCertRenewBefore) : duration(self.renewBefore)) < (has(self.clientCertDuration) ? duration(self.clientCertDuration) : duration('8760h'))",message="clientCertRenewBefore (or renewBefore) must be shorter than clientCertDuration (default 8760h)"
+// +kubebuilder:validation:XValidation:rule="!has(self.oidcDuration) && !has(self.oidcRenewBefore) || (has(self.oidcRenewBefore) ? duration(self.oidcRenewBefore) : (has(self.renewBefore) ? duration(self.renewBefore) : duration('720h'))) < (has(self.oidcDuration) ? duration(self.oidcDuration) : duration('175200h'))",message="oidcRenewBefore (or renewBefore) must be shorter than oidcDuration (default 175200h)"
+// +kubebuilder:validation:XValidation:rule="!has(self.oidcDuration) && !has(self.oidcRenewBefore) || self.environment in ['system', 'infra']",message="oidcDuration and oidcRenewBefore are only supported for the system and infra environments"
+// +kubebuilder:validation:XValidation:rule="self.environment != 'infra' || (has(self.issuerRefOidc) && self.issuerRefOidc.name != '')",message="issuerRefOidc.name is required for the infra environment: infra clusters sign the OIDC certificate with an external issuer"
+// +kubebuilder:validation:XValidation:rule="!has(self.oidcDNSNames) || self.environment in ['system', 'infra']",message="oidcDNSNames are only supported for the system and infra environments"
+// +kubebuilder:validation:XValidation:rule="!has(self.oidcCABundleConfigMap) || self.environment == 'infra'",message="oidcCABundleConfigMap is only supported for the infra environment"
+// +kubebuilder:validation:XValidation:rule="!has(self.publishComponentCABundles) || !self.publishComponentCABundles || self.environment in ['system', 'infra']",message="publishComponentCABundles is only supported for the system and infra environments"
+// +kubebuilder:validation:XValidation:rule="!has(self.pkcs12) || !self.pkcs12 || has(self.pkcs12PasswordSecretRef)",message="pkcs12PasswordSecretRef is required when pkcs12 is enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.jksCABundle) || !self.jksCABundle || has(self.jksPasswordSecretRef)",message="jksPasswordSecretRef is required when jksCABundle is enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.kubeconfigAuthMode) || self.kubeconfigAuthMode != 'token' || has(self.tokenSecretRef)",message="tokenSecretRef is required when kubeconfigAuthMode is token"
+// +kubebuilder:validation:XValidation:rule="(has(self.kubeconfigAuthMode) && self.kubeconfigAuthMode == 'exec') == has(self.kubeconfigExec)",message="kubeconfigExec is required when kubeconfigAuthMode is exec and only allowed with it"
+// +kubebuilder:validation:XValidation:rule="!has(self.etcdLeafCertificates) || !self.etcdLeafCertificates || (self.environment in ['system', 'infra'] && (!has(self.generateETCD) || self.generateETCD))",message="etcdLeafCertificates requires the ETCD CA (system/infra environment with generateETCD)"
+// +kubebuilder:validation:XValidation:rule="!has(self.etcdLeafCertificates) || !self.etcdLeafCertificates || has(self.etcdDNSNames) || has(self.etcdIPAddresses)",message="etcdDNSNames or etcdIPAddresses is required when etcdLeafCertificates is enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.frontProxyClientCertificate) || !self.frontProxyClientCertificate || (self.environment in ['system', 'infra'] && (!has(self.generateProxy) || self.generateProxy))",message="frontProxyClientCertificate requires the Proxy CA (system/infra environment with generateProxy)"
+// +kubebuilder:validation:XValidation:rule="!has(self.argocdInsecure) || !self.argocdInsecure || (has(self.argocdCluster) && self.argocdCluster)",message="argocdInsecure requires argocdCluster"
+// +kubebuilder:validation:XValidation:rule="!has(self.argocdNamespace) || !has(self.argocdTargets)",message="argocdNamespace and argocdTargets are mutually exclusive"
+// +kubebuilder:validation:XValidation:rule="!has(self.kubeconfigTemplateRef) || self.kubeconfig",message="kubeconfigTemplateRef requires kubeconfig"
+// +kubebuilder:validation:XValidation:rule="!has(self.kubeconfigSecretType) || self.kubeconfig",message="kubeconfigSecretType requires kubeconfig"
+// +kubebuilder:validation:XValidation:rule="!has(self.kubeconfigMirrorNamespaces) || size(self.kubeconfigMirrorNamespaces) == 0 || self.kubeconfig",message="kubeconfigMirrorNamespaces requires kubeconfig"
+// +kubebuilder:validation:XValidation:rule="!has(self.caConfigMapKey) || (has(self.publishCAConfigMap) && self.publishCAConfigMap)",message="caConfigMapKey requires publishCAConfigMap"
+// +kubebuilder:validation:XValidation:rule="!has(self.generateClusterInfo) || !self.generateClusterInfo || self.kubeconfig",message="generateClusterInfo requires kubeconfig"
+// +kubebuilder:validation:XValidation:rule="!has(self.publishKubeconfigInStatus) || !self.publishKubeconfigInStatus || self.kubeconfig",message="publishKubeconfigInStatus requires kubeconfig"
+// +kubebuilder:validation:XValidation:rule="!has(self.targetNamespace) || ((!has(self.issuerRef) || self.issuerRef.kind == 'ClusterIssuer') && (!has(self.issuerRefOidc) || self.issuerRefOidc.kind == 'ClusterIssuer'))",message="issuerRef and issuerRefOidc must be ClusterIssuers when targetNamespace is set"
+// +kubebuilder:validation:XValidation:rule="(has(self.selfSignedCA) && self.selfSignedCA) || (has(self.issuerRef) && self.issuerRef.name != '')",message="issuerRef.name is required unless selfSignedCA is set"
+// +kubebuilder:validation:XValidation:rule="!has(self.selfSignedCA) || !self.selfSignedCA || (self.environment == 'client' && !has(self.existingCASecretRef))",message="selfSignedCA is only supported for the client environment without existingCASecretRef"
+// +kubebuilder:validation:XValidation:rule="(has(self.selfSignedCA) && self.selfSignedCA) == (has(oldSelf.selfSignedCA) && oldSelf.selfSignedCA)",message="selfSignedCA is immutable after creation"
+// +kubebuilder:validation:XValidation:rule="has(self.targetNamespace) == has(oldSelf.targetNamespace)",message="targetNamespace cannot be added or removed after creation"
+// +kubebuilder:validation:XValidation:rule="has(self.existingCASecretRef) == has(oldSelf.existingCASecretRef)",message="existingCASecretRef cannot be added or removed after creation"
+// +kubebuilder:validation:XValidation:rule="!has(self.existingCASecretRef) || !has(self.caCommonName)",message="caCommonName cannot be combined with existingCASecretRef"
+// +kubebuilder:validation:XValidation:rule="(!self.kubeconfig && (!has(self.argocdCluster) || !self.argocdCluster)) || (has(self.kubeconfigEndpoint) && self.kubeconfigEndpoint !='')",message="kubeconfigEndpoint is required when kubeconfig or argocdCluster is enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.literalSubject) || (!has(self.subject) && !has(self.clientOrganizations))",message="literalSubject is mutually exclusive with subject and clientOrganizations"
+type CertificateSetSpec struct {
+	// ArgocdCluster enables creation of a secret with cluster credentials for ArgoCD
+	// +optional
+	ArgocdCluster bool `json:"argocdCluster,omitempty"`
+
+	// Environment specifies which certificate set to generate: client, system, or infra.
+	// This field is immutable after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="environment is immutable after creation"
+	// +required
+	Environment EnvironmentType `json:"environment"`
+
+	// Kubeconfig enables creation of kubeconfig secret. This field is immutable after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="kubeconfig is immutable after creation"
+	// +required
+	Kubeconfig bool `json:"kubeconfig"`
+
+	// IssuerRef references the cert-manager issuer for main certificates. Not used (and may be omitted) with selfSignedCA.
+	// +optional
+	IssuerRef IssuerReference `json:"issuerRef"`
+
+	// SelfSignedCA makes a client CertificateSet self-sign ${name}-ca through a SelfSigned Issuer ${name}-selfsigned
+	// instead of requesting it from issuerRef. The super-admin and additional client certificates are signed by that CA
+	// as usual. Only for the client environment; immutable after creation.
+	// +optional
+	SelfSignedCA bool `json:"selfSignedCA,omitempty"`
+
+	// IssuerRefOidc references the cert-manager issuer for OIDC certificates (required for infra environment, enforced by CEL)
+	// +optional
+	IssuerRefOidc *IssuerReference `json:"issuerRefOidc,omitempty"`
+
+	// GenerateETCD enables the ETCD CA certificate for system/infra environments. Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	GenerateETCD *bool `json:"generateETCD,omitempty"`
+
+	// ETCDLeafCertificates issues ${name}-etcd-server and ${name}-etcd-peer certificates from the ETCD CA
+	// through an Issuer ${name}-etcd. Requires the ETCD CA.
+	// +optional
+	ETCDLeafCertificates bool `json:"etcdLeafCertificates,omitempty"`
+
+	// ETCDDNSNames are DNS SANs of the etcd-server and etcd-peer certificates
+	// +kubebuilder:validation:items:MinLength=1
+	// +optional
+	ETCDDNSNames []string `json:"etcdDNSNames,omitempty"`
+
+	// ETCDIPAddresses are IP SANs of the etcd-server and etcd-peer certificates
+	// +kubebuilder:validation:items:MinLength=1
+	// +optional
+	ETCDIPAddresses []string `json:"etcdIPAddresses,omitempty"`
+
+	// GenerateProxy enables the Proxy CA certificate for system/infra environments. Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	GenerateProxy *bool `json:"generateProxy,omitempty"`
+
+	// FrontProxyClientCertificate issues ${name}-front-proxy-client (CN front-proxy-client, client auth) from the
+	// Proxy CA through an Issuer ${name}-proxy, for the API server --proxy-client-cert-file. Requires the Proxy CA.
+	// +optional
+	FrontProxyClientCertificate bool `json:"frontProxyClientCertificate,omitempty"`
+
+	// TargetNamespace is the namespace where Certificates, the Issuer and derived Secrets are created.
+	// Defaults to the CertificateSet namespace. Requires ClusterIssuers in issuerRef and issuerRefOidc.
+	// Resources in another namespace carry owner labels instead of OwnerReferences and are removed by
+	// the finalizer. This field is immutable after creation.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="targetNamespace is immutable after creation"
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
+	// OIDCCABundleConfigMap is the name of a ConfigMap in the target namespace that receives
+	// the ca.crt of the OIDC Secret (for the API server --oidc-ca-file). Only for the infra environment.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	OIDCCABundleConfigMap string `json:"oidcCABundleConfigMap,omitempty"`
+
+	// ArgoCDNamespace is the namespace where the ArgoCD cluster Secret is created.
+	// Defaults to beget-argocd when unset.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	ArgoCDNamespace string `json:"argocdNamespace,omitempty"`
+
+	// ArgoCDTargets lists several ArgoCD instances, one cluster Secret is created per target.
+	// Replaces argocdNamespace; namespaces must be unique.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=namespace
+	// +optional
+	ArgoCDTargets []ArgoCDTarget `json:"argocdTargets,omitempty"`
+
+	// ArgoCDProject scopes the ArgoCD cluster to an AppProject via the "project" key of the cluster Secret.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	ArgoCDProject string `json:"argocdProject,omitempty"`
+
+	// ArgoCDClusterLabels are extra labels for the ArgoCD cluster Secret only, e.g. argocd.argoproj.io/cluster-shard.
+	// They are merged over secretLabels; the secret-type label still wins.
+	// +optional
+	ArgoCDClusterLabels map[string]string `json:"argocdClusterLabels,omitempty"`
+
+	// ArgoCDSecretTypeLabel overrides the label key set to "cluster" on the ArgoCD cluster Secret.
+	// Defaults to argocd.argoproj.io/secret-type when unset.
+	// +kubebuilder:validation:MaxLength=317
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`
+	// +optional
+	ArgoCDSecretTypeLabel string `json:"argocdSecretTypeLabel,omitempty"`
+
+	// ArgoCDSkipSecretTypeLabel suppresses the secret-type label on the ArgoCD cluster Secret,
+	// e.g. when clusters are discovered by a selector built from secretLabels.
+	// +optional
+	ArgoCDSkipSecretTypeLabel bool `json:"argocdSkipSecretTypeLabel,omitempty"`
+
+	// ArgoCDInsecure sets tlsClientConfig.insecure in the ArgoCD cluster Secret, so ArgoCD skips verification
+	// of the API server certificate, e.g. behind a proxy with a certificate ArgoCD does not trust.
+	// caData is omitted then, since a CA cannot be combined with insecure. Meant as a temporary workaround.
+	// +optional
+	ArgoCDInsecure bool `json:"argocdInsecure,omitempty"`
+
+	// IssuerScope selects whether the CA is exposed as a namespaced Issuer or a ClusterIssuer.
+	// Defaults to Issuer. This field is immutable after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="issuerScope is immutable after creation"
+	// +optional
+	IssuerScope IssuerScope `json:"issuerScope,omitempty"`
+
+	// KubeconfigEndpoint is the API server URL for kubeconfig generation, e.g. https://[fd00::1]:6443.
+	// It is written to kubeconfig and ArgoCD Secrets verbatim.
+	// Once set, this field cannot be changed (but can be initially empty).
+	// +kubebuilder:validation:XValidation:rule="oldSelf == '' || self == oldSelf",message="kubeconfigEndpoint cannot be changed once set"
+	// +kubebuilder:validation:XValidation:rule="self == '' || (isURL(self) && url(self).getScheme() in ['http', 'https'] && url(self).getHostname() != '' && (!url(self).getHostname().contains(':') || url(self).getHost().startsWith('[')))",message="kubeconfigEndpoint must be an http(s) URL with a host (IPv6 in brackets), e.g. https://api.example.com:6443 or https://[fd00::1]:6443"
+	// +optional
+	KubeconfigEndpoint string `json:"kubeconfigEndpoint,omitempty"`
+
+	// KubeconfigClusterName overrides the cluster name in generated kubeconfigs. Defaults to the CertificateSet name.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([A-Za-z0-9._@:-]*[A-Za-z0-9])?$`
+	// +optional
+	KubeconfigClusterName string `json:"kubeconfigClusterName,omitempty"`
+
+	// KubeconfigContextName overrides the context name in the super-admin kubeconfig.
+	// Defaults to ${name}-super-admin@${clusterName}.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9]([A-Za-z0-9._@:-]*[A-Za-z0-9])?$`
+	// +optional
+	KubeconfigContextName string `json:"kubeconfigContextName,omitempty"`
+
+	// KubeconfigSecretKey is the data key under which generated kubeconfig Secrets store the kubeconfig.
+	// +kubebuilder:default=value
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	// +optional
+	KubeconfigSecretKey string `json:"kubeconfigSecretKey,omitempty"`
+
+	// KubeconfigSecretType sets the type of the ${name}-kubeconfig Secret, e.g. for GitOps or backup tools
+	// that select Secrets by type. Defaults to Opaque. The type of an existing Secret cannot be changed:
+	// after changing this field the Secret must be deleted manually to be recreated with the new type.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*/)?[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`
+	// +kubebuilder:validation:XValidation:rule="!self.startsWith('kubernetes.io/')",message="kubeconfigSecretType cannot be a built-in kubernetes.io/ type: they require specific data keys"
+	// +optional
+	KubeconfigSecretType string `json:"kubeconfigSecretType,omitempty"`
+
+	// KubeconfigMirrorNamespaces lists namespaces that get a copy of the ${name}-kubeconfig Secret, so teams there
+	// can read it without cluster-wide RBAC. Copies are kept in sync with the source, carry owner labels and are
+	// removed by the finalizer or when their namespace is dropped from the list. The target namespace is skipped.
+	// +kubebuilder:validation:items:MaxLength=63
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +listType=set
+	// +optional
+	KubeconfigMirrorNamespaces []string `json:"kubeconfigMirrorNamespaces,omitempty"`
+
+	// KubeconfigAuthMode selects how the kubeconfig user authenticates: clientcert (default) embeds
+	// the super-admin certificate, token embeds a bearer token from TokenSecretRef, exec runs the
+	// credential plugin configured by KubeconfigExec.
+	// +optional
+	KubeconfigAuthMode KubeconfigAuthMode `json:"kubeconfigAuthMode,omitempty"`
+
+	// KubeconfigExec configures the credential plugin of the kubeconfig user for kubeconfigAuthMode=exec
+	// +optional
+	KubeconfigExec *KubeconfigExec `json:"kubeconfigExec,omitempty"`
+
+	// TokenSecretRef references the Secret key holding the bearer token for kubeconfigAuthMode=token.
+	// The Secret must be in the target namespace (the CertificateSet namespace by default).
+	// +optional
+	TokenSecretRef *SecretKeyReference `json:"tokenSecretRef,omitempty"`
+
+	// KubeconfigTemplateRef references a ConfigMap key in the target namespace holding a Go text/template
+	// that replaces the built-in kubeconfig template, e.g. to add proxy-url or tls-server-name. The template
+	// receives .ClusterName, .ContextName, .UserName, .Server, .CACert, .TLSCert, .TLSKey and .Token.
+	// +optional
+	KubeconfigTemplateRef *ConfigMapKeyReference `json:"kubeconfigTemplateRef,omitempty"`
+
+	// PublishKubeconfigInStatus copies the rendered kubeconfig into status.kubeconfig.
+	// SECURITY: the kubeconfig holds client credentials, and status is readable by everyone who can get
+	// the CertificateSet, without any RBAC on Secrets. Enable only where that is acceptable.
+	// +optional
+	PublishKubeconfigInStatus bool `json:"publishKubeconfigInStatus,omitempty"`
+
+	// GenerateClusterInfo creates a ${name}-cluster-info ConfigMap in the kube-public cluster-info format:
+	// a kubeconfig with only the cluster stanza (server and certificate-authority-data), without credentials
+	// +optional
+	GenerateClusterInfo bool `json:"generateClusterInfo,omitempty"`
+
+	// PublishCABundle creates a ${name}-ca-bundle Secret holding only the CA certificate (ca.crt), without a private key
+	// +optional
+	PublishCABundle bool `json:"publishCABundle,omitempty"`
+
+	// PublishCAConfigMap creates a ${name}-ca-cert ConfigMap holding the CA certificate, e.g. for webhook
+	// and APIService caBundle injection by cainjector-style tooling
+	// +optional
+	PublishCAConfigMap bool `json:"publishCAConfigMap,omitempty"`
+
+	// CAConfigMapKey is the data key of the CA certificate in the ${name}-ca-cert ConfigMap. Defaults to ca.crt.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	// +optional
+	CAConfigMapKey string `json:"caConfigMapKey,omitempty"`
+
+	// PublishComponentCABundles creates ${name}-etcd-ca-bundle, ${name}-proxy-ca-bundle and ${name}-ca-oidc-bundle
+	// Secrets holding only ca.crt of the etcd, Proxy and OIDC CAs (system and infra only), e.g. for kubeadm-style mounts
+	// +optional
+	PublishComponentCABundles bool `json:"publishComponentCABundles,omitempty"`
+
+	// FullChainSecret creates a ${name}-fullchain Secret with a single fullchain.pem key:
+	// the super-admin certificate followed by the CA certificate. Issues the super-admin certificate.
+	// +optional
+	FullChainSecret bool `json:"fullChainSecret,omitempty"`
+
+	// Pkcs12 adds a PKCS#12 keystore (keystore.p12, truststore.p12) to the super-admin Secret
+	// +optional
+	Pkcs12 bool `json:"pkcs12,omitempty"`
+
+	// Pkcs12PasswordSecretRef references the Secret key holding the PKCS#12 keystore password.
+	// The Secret must be in the target namespace (the CertificateSet namespace by default).
+	// +optional
+	Pkcs12PasswordSecretRef *SecretKeyReference `json:"pkcs12PasswordSecretRef,omitempty"`
+
+	// JksCABundle creates a ${name}-ca-jks Secret holding a JKS truststore (truststore.jks) with the CA certificate
+	// +optional
+	JksCABundle bool `json:"jksCABundle,omitempty"`
+
+	// JksPasswordSecretRef references the Secret key holding the JKS truststore password.
+	// The Secret must be in the target namespace (the CertificateSet namespace by default).
+	// +optional
+	JksPasswordSecretRef *SecretKeyReference `json:"jksPasswordSecretRef,omitempty"`
+
+	// PropagateLabels copies the CertificateSet labels onto every child Certificate, Issuer, issued Secret,
+	// derived Secret and ConfigMap. When false, children only get the owner labels the controller
+	// sets outside the CertificateSet namespace (plus spec.secretLabels and the ArgoCD secret-type label on derived Secrets). Defaults to true.
+	// +kubebuilder:default=true
+	// +optional
+	PropagateLabels *bool `json:"propagateLabels,omitempty"`
+
+	// SecretLabels are extra labels added to the derived Secrets (kubeconfig and ArgoCD cluster).
+	// They are merged over the CertificateSet labels and are not applied to Certificates.
+	// +optional
+	SecretLabels map[string]string `json:"secretLabels,omitempty"`
+
+	// SecretAnnotations are extra annotations added to the derived Secrets (kubeconfig and ArgoCD cluster).
+	// They are merged over the CertificateSet annotations and are not applied to Certificates.
+	// +optional
+	SecretAnnotations map[string]string `json:"secretAnnotations,omitempty"`
+
+	// CertificateSecretAnnotations are added to the Secrets issued by cert-manager for every Certificate
+	// (spec.secretTemplate.annotations), e.g. reflector/replicator annotations on the CA Secret
+	// +optional
+	CertificateSecretAnnotations map[string]string `json:"certificateSecretAnnotations,omitempty"`
+
+	// OrphanSecretsOnDelete keeps the Secrets listed in status.generatedSecrets when the CertificateSet is deleted.
+	// Owner references and owner labels are removed from them, and the ArgoCD cluster Secrets are not deleted.
+	// The Secrets are no longer managed by the operator afterwards.
+	// +optional
+	OrphanSecretsOnDelete bool `json:"orphanSecretsOnDelete,omitempty"`
+
+	// CADuration overrides the validity period of the CA, ETCD and Proxy certificates.
+	// Defaults to 175200h (20 years) when unset.
+	// +optional
+	CADuration *metav1.Duration `json:"caDuration,omitempty"`
+
+	// CARotationPolicy is the private key rotation policy of the CA certificates. Defaults to Never.
+	// Always re-keys the CA on every renewal, so every certificate and kubeconfig it signed has to be re-issued.
+	// +optional
+	CARotationPolicy CARotationPolicy `json:"caRotationPolicy,omitempty"`
+
+	// CACommonName overrides the CN of the ${name}-ca certificate, e.g. "Acme Cluster Root CA".
+	// The Certificate and Secret names stay ${name}-ca. Defaults to ${name}-ca when unset.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	CACommonName string `json:"caCommonName,omitempty"`
+
+	// ExistingCASecretRef uses a CA Secret (tls.crt, tls.key) in the target namespace instead of issuing ${name}-ca.
+	// The Issuer or ClusterIssuer signs client certificates with it; the operator never modifies or deletes it.
+	// This field is immutable after creation.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="existingCASecretRef is immutable after creation"
+	// +optional
+	ExistingCASecretRef *SecretReference `json:"existingCASecretRef,omitempty"`
+
+	// RenewBefore overrides how long before expiry cert-manager renews the certificates.
+	// Applies to all certificates unless ClientCertRenewBefore is set for client certificates.
+	// Defaults to 720h (30 days) when unset.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('5m')",message="renewBefore must be at least 5m"
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// ClientCertRenewBefore overrides RenewBefore for the super-admin and additional client certificates.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('5m')",message="clientCertRenewBefore must be at least 5m"
+	// +optional
+	ClientCertRenewBefore *metav1.Duration `json:"clientCertRenewBefore,omitempty"`
+
+	// ClientCertDuration overrides the validity period of the super-admin client certificate.
+	// Defaults to 8760h (1 year) when unset. Unless renewBefore is set explicitly, durations up to 720h
+	// are renewed by cert-manager at 2/3 of their lifetime instead of 30 days before expiry.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1h')",message="clientCertDuration must be at least 1h"
+	// +optional
+	ClientCertDuration *metav1.Duration `json:"clientCertDuration,omitempty"`
+
+	// OIDCDuration overrides the validity period of the ${name}-ca-oidc certificate (system and infra only),
+	// e.g. to stay within the maximum duration of the external issuerRefOidc. Defaults to 175200h (20 years) when unset.
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1h')",message="oidcDuration must be at least 1h"
+	// +optional
+	OIDCDuration *metav1.Duration `json:"oidcDuration,omitempty"`
+
+	// OIDCRenewBefore overrides RenewBefore for the ${name}-ca-oidc certificate
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('5m')",message="oidcRenewBefore must be at least 5m"
+	// +optional
+	OIDCRenewBefore *metav1.Duration `json:"oidcRenewBefore,omitempty"`
+
+	// OIDCDNSNames are DNS SANs of the ${name}-ca-oidc certificate (system and infra only), for setups that
+	// serve the OIDC discovery endpoint with it. No SANs are set by default.
+	// +kubebuilder:validation:items:MaxLength=253
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	OIDCDNSNames []string `json:"oidcDNSNames,omitempty"`
+
+	// ClientOrganizations replace the super-admin subject organizations (system:masters by default)
+	// to map the generated identity to a narrower RBAC group
+	// +kubebuilder:validation:items:MinLength=1
+	// +optional
+	ClientOrganizations []string `json:"clientOrganizations,omitempty"`
+
+	// ClientDNSNames are DNS SANs added to the super-admin client certificate
+	// +optional
+	ClientDNSNames []string `json:"clientDNSNames,omitempty"`
+
+	// ClientIPAddresses are IP SANs added to the super-admin client certificate
+	// +optional
+	ClientIPAddresses []string `json:"clientIPAddresses,omitempty"`
+
+	// CAUsages are the cert-manager key usages of the CA certificates (CA, ETCD, Proxy and the system OIDC CA).
+	// Defaults to cert sign, key encipherment and digital signature; must include cert sign.
+	// +kubebuilder:validation:MaxItems=23
+	// +kubebuilder:validation:items:Enum="signing";"digital signature";"content commitment";"key encipherment";"key agreement";"data encipherment";"cert sign";"crl sign";"encipher only";"decipher only";"any";"server auth";"client auth";"code signing";"email protection";"s/mime";"ipsec end system";"ipsec tunnel";"ipsec user";"timestamping";"ocsp signing";"microsoft sgc";"netscape sgc"
+	// +kubebuilder:validation:XValidation:rule="size(self) == 0 || self.exists(u, u == 'cert sign')",message="caUsages must include cert sign"
+	// +optional
+	CAUsages []string `json:"caUsages,omitempty"`
+
+	// Subject adds X.509 subject fields to the super-admin certificate and, with applyToCA, to the CA certificates
+	// +optional
+	Subject *CertificateSubject `json:"subject,omitempty"`
+
+	// LiteralSubject is the exact RFC 4514 subject of the super-admin certificate, e.g. "CN=admin,O=system:masters",
+	// for CA policies that require a fixed RDN order. It replaces the common name, clientOrganizations and subject,
+	// must contain a CN and is passed to cert-manager as literalSubject.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	LiteralSubject string `json:"literalSubject,omitempty"`
+
+	// ClientCertificates are additional client certificates signed by the CA Issuer.
+	// A kubeconfig Secret is generated for each of them.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ClientCertificates []ClientCertSpec `json:"clientCertificates,omitempty"`
+
+	// PrivateKeyAlgorithm is the private key algorithm for all generated certificates.
+	// Defaults to rsa.
+	// +optional
+	PrivateKeyAlgorithm PrivateKeyAlgorithm `json:"privateKeyAlgorithm,omitempty"`
+
+	// PrivateKeySize is the private key size: 2048, 3072 or 4096 for rsa; 256, 384 or 521 for ecdsa.
+	// Defaults to 2048 for rsa and 256 for ecdsa.
+	// +optional
+	PrivateKeySize int `json:"privateKeySize,omitempty"`
+
+	// KeySizes overrides PrivateKeySize per certificate role
+	// +optional
+	KeySizes *KeySizes `json:"keySizes,omitempty"`
+
+	// PrivateKeyEncoding is the encoding of tls.key in all issued Secrets. Defaults to the cert-manager
+	// default (PKCS1). Changing it makes cert-manager re-issue every certificate.
+	// +optional
+	PrivateKeyEncoding PrivateKeyEncoding `json:"privateKeyEncoding,omitempty"`
+}
+
+// CertificateSubject holds additional X.509 subject fields. Lengths follow the RFC 5280 upper bounds.
+type CertificateSubject struct {
+	// Countries are ISO 3166-1 alpha-2 country codes (C)
+	// +kubebuilder:validation:items:Pattern=`^[A-Z]{2}$`
+	// +optional
+	Countries []string `json:"countries,omitempty"`
+
+	// OrganizationalUnits are the organizational units (OU)
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=64
+	// +optional
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+
+	// Localities are the localities or cities (L)
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	// +optional
+	Localities []string `json:"localities,omitempty"`
+
+	// Provinces are the states or provinces (ST)
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=128
+	// +optional
+	Provinces []string `json:"provinces,omitempty"`
+
+	// ApplyToCA also sets the subject on the CA, ETCD, Proxy and (system) OIDC CA certificates
+	// +optional
+	ApplyToCA bool `json:"applyToCA,omitempty"`
+}
+
+// KeySizes defines private key sizes per certificate role. Allowed values follow PrivateKeySize.
+type KeySizes struct {
+	// CA is the key size for CA, ETCD, Proxy and OIDC certificates
+	// +optional
+	CA int `json:"ca,omitempty"`
+
+	// Leaf is the key size for the super-admin and additional client certificates
+	// +optional
+	Leaf int `json:"leaf,omitempty"`
+}
+
+// IssuerReference contains the reference to a cert-manager issuer (k8s ObjectReference style)
+type IssuerReference struct {
+	// APIVersion is the API version of the issuer (e.g., cert-manager.io/v1)
+	// +kubebuilder:default="cert-manager.io/v1"
+	// +kubebuilder:validation:Pattern=`^cert-manager\.io/v[0-9]+((alpha|beta)[0-9]+)?$`
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind is the kind of the issuer (Issuer or ClusterIssuer)
+	// +kubebuilder:default=ClusterIssuer
+	// +kubebuilder:validation:XValidation:rule="self in ['Issuer', 'ClusterIssuer']",message="kind must be exactly Issuer or ClusterIssuer"
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name is the name of the issuer
+	// +required
+	Name string `json:"name"`
+}
+
+// SecretPurpose describes what a generated Secret is used for
+type SecretPurpose string
+
+const (
+	// SecretPurposeCA is the main CA Secret issued by cert-manager
+	SecretPurposeCA SecretPurpose = "ca"
+	// SecretPurposeETCD is the ETCD CA Secret issued by cert-manager
+	SecretPurposeETCD SecretPurpose = "etcd"
+	// SecretPurposeETCDServer is the etcd server certificate Secret issued by cert-manager
+	SecretPurposeETCDServer SecretPurpose = "etcd-server"
+	// SecretPurposeETCDPeer is the etcd peer certificate Secret issued by cert-manager
+	SecretPurposeETCDPeer SecretPurpose = "etcd-peer"
+	// SecretPurposeFrontProxyClient is the front-proxy client certificate Secret issued by cert-manager
+	SecretPurposeFrontProxyClient SecretPurpose = "front-proxy-client"
+	// SecretPurposeProxy is the Proxy CA Secret issued by cert-manager
+	SecretPurposeProxy SecretPurpose = "proxy"
+	// SecretPurposeCAOIDC is the OIDC Secret issued by cert-manager
+	SecretPurposeCAOIDC SecretPurpose = "ca-oidc"
+	// SecretPurposeSuperAdmin is the super-admin client certificate Secret issued by cert-manager
+	SecretPurposeSuperAdmin SecretPurpose = "super-admin"
+	// SecretPurposeKubeconfig is the kubeconfig Secret rendered by the controller
+	SecretPurposeKubeconfig SecretPurpose = "kubeconfig"
+	// SecretPurposeKubeconfigMirror is a copy of the kubeconfig Secret in a spec.kubeconfigMirrorNamespaces namespace
+	SecretPurposeKubeconfigMirror SecretPurpose = "kubeconfig-mirror"
+	// SecretPurposeArgoCDCluster is the ArgoCD cluster Secret rendered by the controller
+	SecretPurposeArgoCDCluster SecretPurpose = "argocd-cluster"
+	// SecretPurposeCABundle is the CA trust bundle Secret rendered by the controller
+	SecretPurposeCABundle SecretPurpose = "ca-bundle"
+	// SecretPurposeETCDCABundle is the etcd CA bundle Secret rendered by the controller
+	SecretPurposeETCDCABundle SecretPurpose = "etcd-ca-bundle"
+	// SecretPurposeProxyCABundle is the Proxy CA bundle Secret rendered by the controller
+	SecretPurposeProxyCABundle SecretPurpose = "proxy-ca-bundle"
+	// SecretPurposeCAOIDCBundle is the OIDC CA bundle Secret rendered by the controller
+	SecretPurposeCAOIDCBundle SecretPurpose = "ca-oidc-bundle"
+	// SecretPurposeFullChain is the full chain PEM Secret rendered by the controller
+	SecretPurposeFullChain SecretPurpose = "fullchain"
+	// SecretPurposeCAJKS is the JKS truststore Secret rendered by the controller
+	SecretPurposeCAJKS SecretPurpose = "ca-jks"
+	// SecretPurposeClientCertificate is an additional client certificate Secret issued by cert-manager
+	SecretPurposeClientCertificate SecretPurpose = "client-certificate"
+	// SecretPurposeClientKubeconfig is the kubeconfig Secret rendered for an additional client certificate
+	SecretPurposeClientKubeconfig SecretPurpose = "client-kubeconfig"
+)
+
+// GeneratedSecret references a Secret created for the CertificateSet
+type GeneratedSecret struct {
+	// Name is the name of the Secret
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret
+	Namespace string `json:"namespace"`
+
+	// Purpose describes what the Secret is used for
+	Purpose SecretPurpose `json:"purpose"`
+}
+
+// CertificateSetPhase is a human-readable summary of the reconciliation progress
+// +kubebuilder:validation:Enum=CreatingCA;WaitingForCASecret;CreatingClientCerts;WaitingForClientSecret;WaitingForResources;Ready;Degraded;Deleting
+type CertificateSetPhase string
+
+const (
+	// PhaseCreatingCA means the CA certificates are being created
+	PhaseCreatingCA CertificateSetPhase = "CreatingCA"
+	// PhaseWaitingForCASecret means cert-manager has not issued the CA Secret yet
+	PhaseWaitingForCASecret CertificateSetPhase = "WaitingForCASecret"
+	// PhaseCreatingClientCerts means the Issuer and client certificates are being created
+	PhaseCreatingClientCerts CertificateSetPhase = "CreatingClientCerts"
+	// PhaseWaitingForClientSecret means cert-manager has not issued the super-admin Secret yet
+	PhaseWaitingForClientSecret CertificateSetPhase = "WaitingForClientSecret"
+	// PhaseWaitingForResources means all resources exist but some are not Ready yet
+	PhaseWaitingForResources CertificateSetPhase = "WaitingForResources"
+	// PhaseReady means all resources are created and Ready
+	PhaseReady CertificateSetPhase = "Ready"
+	// PhaseDegraded means the last reconciliation failed
+	PhaseDegraded CertificateSetPhase = "Degraded"
+	// PhaseDeleting means the CertificateSet is being deleted and its resources are cleaned up
+	PhaseDeleting CertificateSetPhase = "Deleting"
+)
+
+// SecretReference references a Secret in the target namespace
+type SecretReference struct {
+	// Name is the name of the Secret
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+}
+
+// KubeconfigExec is the exec block of the kubeconfig user. The plugin gets the super-admin Secret in
+// CERTIFICATESET_SECRET_NAMESPACE and CERTIFICATESET_SECRET_NAME and prints an ExecCredential with
+// its certificate and key.
+type KubeconfigExec struct {
+	// Command is the credential plugin executable, looked up in PATH when it is not a path
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Command string `json:"command"`
+
+	// Args are passed to the command
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// APIVersion is the ExecCredential version the plugin understands
+	// +kubebuilder:validation:Enum=client.authentication.k8s.io/v1;client.authentication.k8s.io/v1beta1
+	// +kubebuilder:default="client.authentication.k8s.io/v1"
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+// SecretKeyReference references a key of a Secret in the target namespace
+type SecretKeyReference struct {
+	// Name is the name of the Secret
+	// +required
+	Name string `json:"name"`
+
+	// Key is the key in the Secret data
+	// +required
+	Key string `json:"key"`
+}
+
+// ConfigMapKeyReference references a key of a ConfigMap in the target namespace
+type ConfigMapKeyReference struct {
+	// Name is the name of the ConfigMap
+	// +required
+	Name string `json:"name"`
+
+	// Key is the key in the ConfigMap data
+	// +required
+	Key string `json:"key"`
+}
+
+// PlannedResource describes a resource that would be created for the CertificateSet in dry-run mode
+type PlannedResource struct {
+	// Kind is the resource kind (Certificate, Issuer, ClusterIssuer, Secret or ConfigMap)
+	Kind string `json:"kind"`
+
+	// Name is the name of the resource
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the resource, empty for cluster-scoped resources
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// CertificateSetStatus defines the observed state of CertificateSet.
+type CertificateSetStatus struct {
+	// Conditions represent the current state of the CertificateSet resource.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the metadata.generation of the spec that was last reconciled to Ready.
+	// A value lower than metadata.generation means the latest spec is not applied yet.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is a human-readable summary of the reconciliation progress
+	// +optional
+	Phase CertificateSetPhase `json:"phase,omitempty"`
+
+	// GeneratedSecrets lists the Secrets created for this CertificateSet
+	// +optional
+	GeneratedSecrets []GeneratedSecret `json:"generatedSecrets,omitempty"`
+
+	// PlannedResources lists the resources the spec would produce. It is only set
+	// while the certificateset.in-cloud.io/dry-run annotation is "true".
+	// +optional
+	PlannedResources []PlannedResource `json:"plannedResources,omitempty"`
+
+	// CAExpiry is the NotAfter time of the CA certificate
+	// +optional
+	CAExpiry *metav1.Time `json:"caExpiry,omitempty"`
+
+	// ClientExpiry is the NotAfter time of the super-admin certificate
+	// +optional
+	ClientExpiry *metav1.Time `json:"clientExpiry,omitempty"`
+
+	// NextClientRenewal is the renewalTime of the super-admin certificate: cert-manager re-issues it then,
+	// changing the kubeconfig credential
+	// +optional
+	NextClientRenewal *metav1.Time `json:"nextClientRenewal,omitempty"`
+
+	// LastCARotation is the value of the rotate-ca annotation that was last honored
+	// +optional
+	LastCARotation string `json:"lastCARotation,omitempty"`
+
+	// LastResync is the value of the resync annotation that was last honored
+	// +optional
+	LastResync string `json:"lastResync,omitempty"`
+
+	// Kubeconfig is the rendered kubeconfig (base64 in JSON), set only with spec.publishKubeconfigInStatus
+	// +optional
+	Kubeconfig []byte `json:"kubeconfig,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Environment",type=string,JSONPath=".spec.environment"
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="CA Expiry",type=date,JSONPath=".status.caExpiry"
+// +kubebuilder:printcolumn:name="Client Expiry",type=date,JSONPath=".status.clientExpiry"
+// +kubebuilder:printcolumn:name="Next Renewal",type=date,JSONPath=".status.nextClientRenewal"
+// +kubebuilder:printcolumn:name="Observed Generation",type=integer,JSONPath=".status.observedGeneration",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 234",message="metadata.name must be at most 234 characters: with the longest suffix -front-proxy-client child resource names would exceed 253 characters"
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 63 || (!has(self.spec.targetNamespace) && !has(self.spec.kubeconfigMirrorNamespaces) && (!has(self.spec.issuerScope) || self.spec.issuerScope != 'ClusterIssuer'))",message="metadata.name must be at most 63 characters with targetNamespace, kubeconfigMirrorNamespaces or issuerScope ClusterIssuer: it is stored in the owner-name label of resources outside the namespace"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.clientCertificates) || self.spec.clientCertificates.all(c, size(self.metadata.name) + size(c.name) + 12 <= 253)",message="metadata.name and clientCertificates names are too long: ${name}-${clientName} with the suffix -kubeconfig would exceed 253 characters"
+// +kubebuilder:validation:XValidation:rule="!has(self.spec.argocdTargets) || self.spec.argocdTargets.all(t, !has(t.namePrefix) || size(t.namePrefix) + size(self.metadata.name) + 15 <= 253)",message="metadata.name and argocdTargets namePrefix are too long: ${namePrefix}${name} with the suffix -argocd-cluster would exceed 253 characters"
+
+// CertificateSet is the Schema for the certificatesets API
+type CertificateSet struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of CertificateSet
+	// +required
+	Spec CertificateSetSpec `json:"spec"`
+
+	// status defines the observed state of CertificateSet
+	// +optional
+	Status CertificateSetStatus `json:"status,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// CertificateSetList contains a list of CertificateSet
+type CertificateSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []CertificateSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CertificateSet{}, &CertificateSetList{})
+}
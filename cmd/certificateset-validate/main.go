@@ -0,0 +1,160 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// certificateset-validate checks CertificateSet manifests without a cluster, e.g. in CI before kubectl apply.
+// It applies the webhook defaults, runs the controller validation and prints the child resources
+// each CertificateSet would create. Documents of other kinds are skipped.
+//
+//	certificateset-validate [-namespace default] manifest.yaml [...]
+//
+// The exit code is 1 if any CertificateSet is invalid.
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+	"certificate-set/internal/controller"
+	webhookv1alpha1 "certificate-set/internal/webhook/v1alpha1"
+)
+
+func main() {
+	var namespace string
+	flag.StringVar(&namespace, "namespace", "default",
+		"Namespace for CertificateSets without metadata.namespace, as kubectl apply would use.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] manifest.yaml [...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	invalid := false
+	for _, path := range flag.Args() {
+		ok, err := validateFile(path, namespace, os.Stdout)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", path, err)
+			os.Exit(2)
+		}
+		invalid = invalid || !ok
+	}
+	if invalid {
+		os.Exit(1)
+	}
+}
+
+// validateFile validates every CertificateSet in a multi-document YAML or JSON file and reports whether all are valid
+func validateFile(path, namespace string, out io.Writer) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer func() { _ = f.Close() }()
+
+	valid := true
+	decoder := utilyaml.NewYAMLOrJSONDecoder(f, 4096)
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return valid, nil
+			}
+			return false, err
+		}
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+
+		var typeMeta struct {
+			APIVersion string `json:"apiVersion"`
+			Kind       string `json:"kind"`
+		}
+		if err := json.Unmarshal(raw, &typeMeta); err != nil {
+			return false, err
+		}
+		if typeMeta.APIVersion != incloudiov1alpha1.GroupVersion.String() || typeMeta.Kind != "CertificateSet" {
+			continue
+		}
+
+		cs, err := decodeCertificateSet(raw, namespace)
+		if err == nil {
+			err = controller.ValidateCertificateSet(cs)
+		}
+		if err != nil {
+			valid = false
+			_, _ = fmt.Fprintf(out, "%s %s/%s: invalid\n", path, cs.Namespace, cs.Name)
+			for _, e := range unwrapJoined(err) {
+				_, _ = fmt.Fprintf(out, "  - %v\n", e)
+			}
+			continue
+		}
+
+		_, _ = fmt.Fprintf(out, "%s %s/%s: valid\n", path, cs.Namespace, cs.Name)
+		for _, res := range controller.AllManagedResources(cs) {
+			name := res.Name
+			if res.Namespace != "" {
+				name = res.Namespace + "/" + res.Name
+			}
+			_, _ = fmt.Fprintf(out, "  %s %s (%s)\n", res.Kind, name, res.Purpose)
+		}
+	}
+}
+
+// decodeCertificateSet decodes raw strictly, so misspelled fields are reported, and applies the webhook defaults
+// the API server would apply on create. The returned CertificateSet is usable for reporting even on error.
+func decodeCertificateSet(raw []byte, namespace string) (*incloudiov1alpha1.CertificateSet, error) {
+	cs := &incloudiov1alpha1.CertificateSet{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(cs)
+	if cs.Namespace == "" {
+		cs.Namespace = namespace
+	}
+	if err != nil {
+		return cs, err
+	}
+
+	ctx := admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	})
+	return cs, (&webhookv1alpha1.CertificateSetCustomDefaulter{}).Default(ctx, cs)
+}
+
+// unwrapJoined splits an errors.Join result into its errors
+func unwrapJoined(err error) []error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
@@ -17,10 +17,12 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
 	"os"
 	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -41,7 +43,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+	incloudiov1beta1 "certificate-set/api/v1beta1"
 	"certificate-set/internal/controller"
+	"certificate-set/internal/tracing"
+	webhookv1alpha1 "certificate-set/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -55,6 +60,7 @@ func init() {
 	utilruntime.Must(certmanagerv1.AddToScheme(scheme))
 
 	utilruntime.Must(incloudiov1alpha1.AddToScheme(scheme))
+	utilruntime.Must(incloudiov1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -68,6 +74,15 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var clusterWide bool
+	var requireCertificateReady bool
+	var backlogThreshold int
+	var backlogWindow time.Duration
+	var maxConcurrentReconciles int
+	var reconcileTimeout time.Duration
+	var requeueInterval time.Duration
+	var clusterResourceNamespace string
+	var finalizerName string
+	var enableArgoCD bool
 	var watchNamespace string
 	var labelSelector string
 	var tlsOpts []func(*tls.Config)
@@ -94,6 +109,24 @@ func main() {
 		"Filter by namespace")
 	flag.StringVar(&labelSelector, "label-selector", "",
 		"Filter by label in format key=value")
+	flag.BoolVar(&enableArgoCD, "enable-argocd", true,
+		"Enable ArgoCD cluster Secrets; when false, CertificateSets with argocdCluster are rejected")
+	flag.StringVar(&finalizerName, "finalizer-name", controller.DefaultFinalizerName,
+		"Finalizer added to CertificateSets for cross-namespace cleanup")
+	flag.BoolVar(&requireCertificateReady, "require-certificate-ready", true,
+		"Render kubeconfig and ArgoCD Secrets only once the super-admin Certificate is Ready, not just its Secret")
+	flag.IntVar(&maxConcurrentReconciles, "max-concurrent-reconciles", 1,
+		"Number of CertificateSets reconciled in parallel")
+	flag.DurationVar(&requeueInterval, "requeue-interval", controller.DefaultRequeueInterval,
+		"Delay before a reconciliation waiting for cert-manager resources is retried; waiting for Secrets backs off from it")
+	flag.StringVar(&clusterResourceNamespace, "cluster-resource-namespace", controller.DefaultClusterResourceNamespace,
+		"The --cluster-resource-namespace of cert-manager; CertificateSets with issuerScope ClusterIssuer must live there")
+	flag.DurationVar(&reconcileTimeout, "reconcile-timeout", 30*time.Second,
+		"Timeout of a single reconciliation; timed out reconciliations are retried with backoff. 0 disables it")
+	flag.IntVar(&backlogThreshold, "readiness-backlog-threshold", 100,
+		"Workqueue depth above which the controller is considered backlogged; 0 disables the readiness check")
+	flag.DurationVar(&backlogWindow, "readiness-backlog-window", 5*time.Minute,
+		"How long the workqueue may stay above the threshold before readyz fails")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -182,8 +215,10 @@ func main() {
 			setupLog.Info("Filtering by namespace", "namespace", watchNamespace)
 			// Include both watch namespace and ArgoCD namespace for cross-namespace secret management
 			cacheOptions.DefaultNamespaces = map[string]cache.Config{
-				watchNamespace:             {},
-				controller.ArgoCDNamespace: {}, // Required for ArgoCD cluster secrets
+				watchNamespace: {},
+			}
+			if enableArgoCD {
+				cacheOptions.DefaultNamespaces[controller.DefaultArgoCDNamespace] = cache.Config{} // Required for ArgoCD cluster secrets
 			}
 		}
 
@@ -202,6 +237,17 @@ func main() {
 		}
 	}
 
+	ctx := ctrl.SetupSignalHandler()
+
+	shutdownTracing, err := tracing.Setup(ctx)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+	if tracing.Enabled() {
+		setupLog.Info("OpenTelemetry tracing enabled")
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Cache:                  cacheOptions,
 		Scheme:                 scheme,
@@ -220,10 +266,28 @@ func main() {
 		Client:    mgr.GetClient(),
 		Scheme:    mgr.GetScheme(),
 		APIReader: mgr.GetAPIReader(), // Non-caching reader for direct API server reads
+
+		RequireCertificateReady:  requireCertificateReady,
+		FinalizerName:            finalizerName,
+		DisableArgoCD:            !enableArgoCD,
+		MaxConcurrentReconciles:  maxConcurrentReconciles,
+		ReconcileTimeout:         reconcileTimeout,
+		RequeueInterval:          requeueInterval,
+		ClusterResourceNamespace: clusterResourceNamespace,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "CertificateSet")
 		os.Exit(1)
 	}
+	// The webhook server needs a serving certificate, so it only starts where one is mounted:
+	// config/default and the Helm chart with webhook.enable set ENABLE_WEBHOOKS=true.
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") == "true" {
+		// Also serves the /convert endpoint, since v1beta1 converts to the v1alpha1 hub
+		if err := webhookv1alpha1.SetupCertificateSetWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "CertificateSet")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -234,10 +298,21 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if backlogThreshold > 0 {
+		backlog := &controller.BacklogChecker{Threshold: backlogThreshold, Window: backlogWindow}
+		if err := mgr.AddReadyzCheck("workqueue-backlog", backlog.Check); err != nil {
+			setupLog.Error(err, "unable to set up workqueue backlog check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+	if err := mgr.Start(ctx); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+	// Flush pending spans; ctx is already cancelled at this point
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "problem shutting down tracing")
+	}
 }
@@ -0,0 +1,83 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// waitStep names a waiting branch of the reconcile loop. Every step keeps its own attempts, so
+// finishing one wait does not restart the backoff of a later one.
+type waitStep string
+
+const (
+	waitStepCertManager           waitStep = "CertManager"
+	waitStepCASecret              waitStep = "CASecret"
+	waitStepSuperAdminSecret      waitStep = "SuperAdminSecret"
+	waitStepSuperAdminCertificate waitStep = "SuperAdminCertificate"
+)
+
+// requeueBackoff tracks per-object exponential requeue delays for the waiting branches.
+// The zero value is ready to use.
+type requeueBackoff struct {
+	mu       sync.Mutex
+	attempts map[types.NamespacedName]map[waitStep]int
+}
+
+// next returns the delay for the next requeue of key in step (base doubled per attempt, capped at
+// maxDelay) and records the attempt
+func (b *requeueBackoff) next(key types.NamespacedName, step waitStep, base, maxDelay time.Duration) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.attempts == nil {
+		b.attempts = make(map[types.NamespacedName]map[waitStep]int)
+	}
+	if b.attempts[key] == nil {
+		b.attempts[key] = make(map[waitStep]int)
+	}
+	attempt := b.attempts[key][step]
+	b.attempts[key][step] = attempt + 1
+
+	delay := base
+	for i := 0; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// reset forgets the attempts of key in step, once that wait is over
+func (b *requeueBackoff) reset(key types.NamespacedName, step waitStep) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.attempts[key], step)
+	if len(b.attempts[key]) == 0 {
+		delete(b.attempts, key)
+	}
+}
+
+// forget drops all attempts of key, e.g. when the object is deleted
+func (b *requeueBackoff) forget(key types.NamespacedName) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.attempts, key)
+}
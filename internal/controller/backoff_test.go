@@ -0,0 +1,67 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("requeueBackoff", func() {
+	key := types.NamespacedName{Namespace: "default", Name: "demo"}
+	other := types.NamespacedName{Namespace: "default", Name: "other"}
+
+	DescribeTable("next",
+		func(prepare func(b *requeueBackoff), want time.Duration) {
+			b := &requeueBackoff{}
+			prepare(b)
+			Expect(b.next(key, waitStepCASecret, time.Second, 5*time.Second)).To(Equal(want))
+		},
+		Entry("starts at the base delay", func(b *requeueBackoff) {}, time.Second),
+		Entry("doubles per attempt", func(b *requeueBackoff) {
+			b.next(key, waitStepCASecret, time.Second, 5*time.Second)
+			b.next(key, waitStepCASecret, time.Second, 5*time.Second)
+		}, 4*time.Second),
+		Entry("is capped at the maximum", func(b *requeueBackoff) {
+			for range 10 {
+				b.next(key, waitStepCASecret, time.Second, 5*time.Second)
+			}
+		}, 5*time.Second),
+		Entry("counts other objects separately", func(b *requeueBackoff) {
+			b.next(other, waitStepCASecret, time.Second, 5*time.Second)
+		}, time.Second),
+		Entry("counts other steps separately", func(b *requeueBackoff) {
+			b.next(key, waitStepSuperAdminSecret, time.Second, 5*time.Second)
+		}, time.Second),
+		Entry("restarts after reset of the step", func(b *requeueBackoff) {
+			b.next(key, waitStepCASecret, time.Second, 5*time.Second)
+			b.reset(key, waitStepCASecret)
+		}, time.Second),
+		Entry("keeps growing after reset of another step", func(b *requeueBackoff) {
+			b.next(key, waitStepCASecret, time.Second, 5*time.Second)
+			b.reset(key, waitStepSuperAdminSecret)
+		}, 2*time.Second),
+		Entry("restarts after forget", func(b *requeueBackoff) {
+			b.next(key, waitStepCASecret, time.Second, 5*time.Second)
+			b.next(key, waitStepSuperAdminSecret, time.Second, 5*time.Second)
+			b.forget(key)
+		}, time.Second),
+	)
+})
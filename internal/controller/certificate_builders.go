@@ -17,13 +17,19 @@ limitations under the License.
 package controller
 
 import (
+	"encoding/hex"
+	"fmt"
 	"maps"
+	"regexp"
+	"strings"
 	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	incloudiov1alpha1 "certificate-set/api/v1alpha1"
 )
@@ -44,27 +50,183 @@ func copyAnnotationsForChildResource(source map[string]string) map[string]string
 	return result
 }
 
+// validateChildLabels checks the labels copied to child resources (CertificateSet labels, spec.secretLabels
+// and spec.argocdClusterLabels), so a bad key or value is reported by name instead of failing on create
+func validateChildLabels(cs *incloudiov1alpha1.CertificateSet) error {
+	var errs field.ErrorList
+	if propagateLabels(cs) {
+		errs = append(errs, metav1validation.ValidateLabels(cs.Labels, field.NewPath("metadata", "labels"))...)
+	}
+	errs = append(errs, metav1validation.ValidateLabels(cs.Spec.SecretLabels, field.NewPath("spec", "secretLabels"))...)
+	errs = append(errs, metav1validation.ValidateLabels(cs.Spec.ArgoCDClusterLabels, field.NewPath("spec", "argocdClusterLabels"))...)
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidLabels, errs.ToAggregate())
+	}
+	return nil
+}
+
+// propagateLabels reports whether CertificateSet labels are copied to child resources (spec.propagateLabels, true by default)
+func propagateLabels(cs *incloudiov1alpha1.CertificateSet) bool {
+	return cs.Spec.PropagateLabels == nil || *cs.Spec.PropagateLabels
+}
+
+// childLabels returns the labels copied to child resources: the CertificateSet labels, or none when
+// spec.propagateLabels is false. setOwner still adds the owner labels to objects in a target namespace.
+func childLabels(cs *incloudiov1alpha1.CertificateSet) map[string]string {
+	if propagateLabels(cs) {
+		return cs.Labels
+	}
+	return nil
+}
+
+// certificateSecretTemplate returns the labels and annotations cert-manager copies onto issued Secrets
+func certificateSecretTemplate(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.CertificateSecretTemplate {
+	return &certmanagerv1.CertificateSecretTemplate{
+		Labels:      childLabels(cs),
+		Annotations: copyAnnotationsForChildResource(cs.Spec.CertificateSecretAnnotations),
+	}
+}
+
 // buildObjectMeta creates ObjectMeta for child resources
 func buildObjectMeta(cs *incloudiov1alpha1.CertificateSet, name string) metav1.ObjectMeta {
 	return metav1.ObjectMeta{
 		Name:        name,
-		Namespace:   cs.Namespace,
-		Labels:      cs.Labels,
+		Namespace:   TargetNamespace(cs),
+		Labels:      childLabels(cs),
 		Annotations: copyAnnotationsForChildResource(cs.Annotations),
 	}
 }
 
+// privateKeyAlgorithm maps spec.privateKeyAlgorithm to the cert-manager key algorithm (RSA by default)
+func privateKeyAlgorithm(cs *incloudiov1alpha1.CertificateSet) certmanagerv1.PrivateKeyAlgorithm {
+	if cs.Spec.PrivateKeyAlgorithm == incloudiov1alpha1.PrivateKeyAlgorithmECDSA {
+		return certmanagerv1.ECDSAKeyAlgorithm
+	}
+	return certmanagerv1.RSAKeyAlgorithm
+}
+
+// privateKeySize returns spec.privateKeySize or the default size for the configured algorithm
+func privateKeySize(cs *incloudiov1alpha1.CertificateSet) int {
+	if cs.Spec.PrivateKeySize != 0 {
+		return cs.Spec.PrivateKeySize
+	}
+	if privateKeyAlgorithm(cs) == certmanagerv1.ECDSAKeyAlgorithm {
+		return 256
+	}
+	return 2048
+}
+
+// caPrivateKeySize returns spec.keySizes.ca, falling back to privateKeySize
+func caPrivateKeySize(cs *incloudiov1alpha1.CertificateSet) int {
+	if cs.Spec.KeySizes != nil && cs.Spec.KeySizes.CA != 0 {
+		return cs.Spec.KeySizes.CA
+	}
+	return privateKeySize(cs)
+}
+
+// leafPrivateKeySize returns spec.keySizes.leaf, falling back to privateKeySize
+func leafPrivateKeySize(cs *incloudiov1alpha1.CertificateSet) int {
+	if cs.Spec.KeySizes != nil && cs.Spec.KeySizes.Leaf != 0 {
+		return cs.Spec.KeySizes.Leaf
+	}
+	return privateKeySize(cs)
+}
+
 // defaultCAPrivateKey returns the default private key configuration for CA certificates
-func defaultCAPrivateKey() *certmanagerv1.CertificatePrivateKey {
+func defaultCAPrivateKey(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.CertificatePrivateKey {
 	return &certmanagerv1.CertificatePrivateKey{
-		Algorithm:      certmanagerv1.RSAKeyAlgorithm,
-		RotationPolicy: certmanagerv1.RotationPolicyNever,
-		Size:           2048,
+		Algorithm:      privateKeyAlgorithm(cs),
+		Encoding:       privateKeyEncoding(cs),
+		RotationPolicy: caRotationPolicy(cs),
+		Size:           caPrivateKeySize(cs),
+	}
+}
+
+// privateKeyEncoding maps spec.privateKeyEncoding to the cert-manager key encoding. Unset stays empty,
+// so existing Certificates keep the cert-manager default (PKCS1) and are not re-issued.
+func privateKeyEncoding(cs *incloudiov1alpha1.CertificateSet) certmanagerv1.PrivateKeyEncoding {
+	switch cs.Spec.PrivateKeyEncoding {
+	case incloudiov1alpha1.PrivateKeyEncodingPKCS1:
+		return certmanagerv1.PKCS1
+	case incloudiov1alpha1.PrivateKeyEncodingPKCS8:
+		return certmanagerv1.PKCS8
+	}
+	return ""
+}
+
+// caRotationPolicy returns spec.caRotationPolicy, falling back to Never
+func caRotationPolicy(cs *incloudiov1alpha1.CertificateSet) certmanagerv1.PrivateKeyRotationPolicy {
+	if cs.Spec.CARotationPolicy == incloudiov1alpha1.CARotationPolicyAlways {
+		return certmanagerv1.RotationPolicyAlways
+	}
+	return certmanagerv1.RotationPolicyNever
+}
+
+// caDuration returns the validity period for CA certificates, falling back to CertDuration20Years
+func caDuration(cs *incloudiov1alpha1.CertificateSet) *metav1.Duration {
+	if cs.Spec.CADuration != nil {
+		return &metav1.Duration{Duration: cs.Spec.CADuration.Duration}
+	}
+	return &metav1.Duration{Duration: CertDuration20Years}
+}
+
+// clientCertDuration returns the validity period for the super-admin certificate, falling back to CertDuration1Year
+func clientCertDuration(cs *incloudiov1alpha1.CertificateSet) *metav1.Duration {
+	if cs.Spec.ClientCertDuration != nil {
+		return &metav1.Duration{Duration: cs.Spec.ClientCertDuration.Duration}
 	}
+	return &metav1.Duration{Duration: CertDuration1Year}
 }
 
-// caUsages returns the default usages for CA certificates
-func caUsages() []certmanagerv1.KeyUsage {
+// caRenewBefore returns renewBefore for CA certificates, falling back to CertRenewBefore30Days
+func caRenewBefore(cs *incloudiov1alpha1.CertificateSet) *metav1.Duration {
+	if cs.Spec.RenewBefore != nil {
+		return &metav1.Duration{Duration: cs.Spec.RenewBefore.Duration}
+	}
+	return &metav1.Duration{Duration: CertRenewBefore30Days}
+}
+
+// oidcDuration returns the validity period for the OIDC certificate, falling back to CertDuration20Years
+func oidcDuration(cs *incloudiov1alpha1.CertificateSet) *metav1.Duration {
+	if cs.Spec.OIDCDuration != nil {
+		return &metav1.Duration{Duration: cs.Spec.OIDCDuration.Duration}
+	}
+	return &metav1.Duration{Duration: CertDuration20Years}
+}
+
+// oidcRenewBefore returns renewBefore for the OIDC certificate: oidcRenewBefore, then the CA renewBefore
+func oidcRenewBefore(cs *incloudiov1alpha1.CertificateSet) *metav1.Duration {
+	if cs.Spec.OIDCRenewBefore != nil {
+		return &metav1.Duration{Duration: cs.Spec.OIDCRenewBefore.Duration}
+	}
+	return caRenewBefore(cs)
+}
+
+// clientRenewBefore returns renewBefore for client certificates: clientCertRenewBefore, then renewBefore.
+// cert-manager rejects renewBefore >= duration, so when neither is set short-lived certificates
+// leave it unset and cert-manager renews at 2/3 of the lifetime.
+func clientRenewBefore(cs *incloudiov1alpha1.CertificateSet) *metav1.Duration {
+	if cs.Spec.ClientCertRenewBefore != nil {
+		return &metav1.Duration{Duration: cs.Spec.ClientCertRenewBefore.Duration}
+	}
+	if cs.Spec.RenewBefore != nil {
+		return &metav1.Duration{Duration: cs.Spec.RenewBefore.Duration}
+	}
+	if clientCertDuration(cs).Duration <= CertRenewBefore30Days {
+		return nil
+	}
+	return &metav1.Duration{Duration: CertRenewBefore30Days}
+}
+
+// caUsages returns spec.caUsages, falling back to the default usages for CA certificates
+func caUsages(cs *incloudiov1alpha1.CertificateSet) []certmanagerv1.KeyUsage {
+	if len(cs.Spec.CAUsages) > 0 {
+		usages := make([]certmanagerv1.KeyUsage, 0, len(cs.Spec.CAUsages))
+		for _, usage := range cs.Spec.CAUsages {
+			usages = append(usages, certmanagerv1.KeyUsage(usage))
+		}
+		return usages
+	}
 	return []certmanagerv1.KeyUsage{
 		certmanagerv1.UsageCertSign,
 		certmanagerv1.UsageKeyEncipherment,
@@ -72,29 +234,153 @@ func caUsages() []certmanagerv1.KeyUsage {
 	}
 }
 
+// applySubject adds the spec.subject fields to the subject of cert, keeping its organizations
+func applySubject(cs *incloudiov1alpha1.CertificateSet, cert *certmanagerv1.Certificate) {
+	if cs.Spec.Subject == nil {
+		return
+	}
+	if cert.Spec.Subject == nil {
+		cert.Spec.Subject = &certmanagerv1.X509Subject{}
+	}
+	cert.Spec.Subject.Countries = cs.Spec.Subject.Countries
+	cert.Spec.Subject.OrganizationalUnits = cs.Spec.Subject.OrganizationalUnits
+	cert.Spec.Subject.Localities = cs.Spec.Subject.Localities
+	cert.Spec.Subject.Provinces = cs.Spec.Subject.Provinces
+}
+
+// applyCASubject applies spec.subject to a CA certificate when spec.subject.applyToCA is set
+func applyCASubject(cs *incloudiov1alpha1.CertificateSet, cert *certmanagerv1.Certificate) {
+	if cs.Spec.Subject != nil && cs.Spec.Subject.ApplyToCA {
+		applySubject(cs, cert)
+	}
+}
+
+// literalSubjectAttributeTypes are the attribute type names cert-manager maps to OIDs in a literalSubject
+var literalSubjectAttributeTypes = map[string]bool{
+	"C": true, "O": true, "OU": true, "CN": true, "SERIALNUMBER": true,
+	"L": true, "ST": true, "STREET": true, "DC": true, "UID": true,
+}
+
+// literalSubjectOID matches a dotted numeric OID used as an attribute type
+var literalSubjectOID = regexp.MustCompile(`^[0-9]+(\.[0-9]+)+$`)
+
+// validateLiteralSubject checks that subject is an RFC 4514 distinguished name that cert-manager can encode:
+// every attribute type is one cert-manager knows or a numeric OID, values are correctly escaped, and
+// a CN is present, since Kubernetes takes the user name from it.
+func validateLiteralSubject(subject string) error {
+	hasCN := false
+	for _, rdn := range splitUnescaped(subject, ',') {
+		for _, atv := range splitUnescaped(rdn, '+') {
+			attrType, value, ok := strings.Cut(atv, "=")
+			attrType = strings.TrimSpace(attrType)
+			if !ok || attrType == "" {
+				return fmt.Errorf("%w %q: %q is not a type=value pair", ErrInvalidLiteralSubject, subject, atv)
+			}
+			if !literalSubjectAttributeTypes[attrType] && !literalSubjectOID.MatchString(attrType) {
+				return fmt.Errorf("%w %q: unsupported attribute type %q", ErrInvalidLiteralSubject, subject, attrType)
+			}
+			if err := validateLiteralSubjectValue(value); err != nil {
+				return fmt.Errorf("%w %q: value of %s: %w", ErrInvalidLiteralSubject, subject, attrType, err)
+			}
+			hasCN = hasCN || attrType == "CN"
+		}
+	}
+	if !hasCN {
+		return fmt.Errorf("%w %q: CN is required", ErrInvalidLiteralSubject, subject)
+	}
+	return nil
+}
+
+// validateLiteralSubjectValue checks the escaping of an RFC 4514 attribute value
+func validateLiteralSubjectValue(value string) error {
+	if strings.HasPrefix(value, "#") {
+		if _, err := hex.DecodeString(value[1:]); err != nil || len(value) == 1 {
+			return fmt.Errorf("invalid hex-encoded value %q", value)
+		}
+		return nil
+	}
+	for i := 0; i < len(value); i++ {
+		switch value[i] {
+		case '\\':
+			if i+1 < len(value) && strings.IndexByte(` "#+,;<=>\`, value[i+1]) >= 0 {
+				i++
+				continue
+			}
+			if i+2 < len(value) {
+				if _, err := hex.DecodeString(value[i+1 : i+3]); err == nil {
+					i += 2
+					continue
+				}
+			}
+			return fmt.Errorf("invalid escape sequence at position %d", i)
+		case '"', ';', '<', '>':
+			return fmt.Errorf("character %q must be escaped", value[i])
+		}
+	}
+	return nil
+}
+
+// splitUnescaped splits s at every sep that is not preceded by a backslash escape
+func splitUnescaped(s string, sep byte) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case sep:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
 // buildCACertificateWithName creates a CA certificate with the given name
 func buildCACertificateWithName(cs *incloudiov1alpha1.CertificateSet, name string) *certmanagerv1.Certificate {
-	gv, _ := schema.ParseGroupVersion(cs.Spec.IssuerRef.APIVersion)
-	return &certmanagerv1.Certificate{
+	cert := &certmanagerv1.Certificate{
 		ObjectMeta: buildObjectMeta(cs, name),
 		Spec: certmanagerv1.CertificateSpec{
-			CommonName:  name,
-			Duration:    &metav1.Duration{Duration: CertDuration20Years},
-			IsCA:        true,
-			IssuerRef:   cmmeta.ObjectReference{Group: gv.Group, Kind: cs.Spec.IssuerRef.Kind, Name: cs.Spec.IssuerRef.Name},
-			PrivateKey:  defaultCAPrivateKey(),
-			RenewBefore: &metav1.Duration{Duration: CertRenewBefore30Days},
-			SecretName:  name,
-			SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
-				Labels: cs.Labels,
-			},
-			Usages: caUsages(),
+			CommonName:     name,
+			Duration:       caDuration(cs),
+			IsCA:           true,
+			IssuerRef:      issuerObjectRef(cs.Spec.IssuerRef),
+			PrivateKey:     defaultCAPrivateKey(cs),
+			RenewBefore:    caRenewBefore(cs),
+			SecretName:     name,
+			SecretTemplate: certificateSecretTemplate(cs),
+			Usages:         caUsages(cs),
 		},
 	}
+	applyCASubject(cs, cert)
+	return cert
 }
 
 func buildCACertificate(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Certificate {
-	return buildCACertificateWithName(cs, CAName(cs))
+	cert := buildCACertificateWithName(cs, CAName(cs))
+	if cs.Spec.CACommonName != "" {
+		cert.Spec.CommonName = cs.Spec.CACommonName
+	}
+	if usesSelfSignedCA(cs) {
+		cert.Spec.IssuerRef = cmmeta.ObjectReference{
+			Group: certmanagerv1.SchemeGroupVersion.Group,
+			Kind:  certmanagerv1.IssuerKind,
+			Name:  SelfSignedIssuerName(cs),
+		}
+	}
+	return cert
+}
+
+// buildSelfSignedIssuer creates the SelfSigned Issuer that signs ${name}-ca with spec.selfSignedCA
+func buildSelfSignedIssuer(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Issuer {
+	return &certmanagerv1.Issuer{
+		ObjectMeta: buildObjectMeta(cs, SelfSignedIssuerName(cs)),
+		Spec: certmanagerv1.IssuerSpec{
+			IssuerConfig: certmanagerv1.IssuerConfig{
+				SelfSigned: &certmanagerv1.SelfSignedIssuer{},
+			},
+		},
+	}
 }
 
 func buildETCDCertificate(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Certificate {
@@ -106,7 +392,23 @@ func buildProxyCertificate(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.
 }
 
 func buildIssuer(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Issuer {
-	name := CAName(cs)
+	issuer := buildIssuerWithName(cs, CAName(cs))
+	issuer.Spec.CA.SecretName = CASecretName(cs)
+	return issuer
+}
+
+// buildETCDIssuer creates the Issuer signing etcd leaf certificates with the ETCD CA
+func buildETCDIssuer(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Issuer {
+	return buildIssuerWithName(cs, ETCDName(cs))
+}
+
+// buildProxyIssuer creates the Issuer signing the front-proxy client certificate with the Proxy CA
+func buildProxyIssuer(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Issuer {
+	return buildIssuerWithName(cs, ProxyName(cs))
+}
+
+// buildIssuerWithName creates a CA Issuer backed by the CA Secret with the same name
+func buildIssuerWithName(cs *incloudiov1alpha1.CertificateSet, name string) *certmanagerv1.Issuer {
 	return &certmanagerv1.Issuer{
 		ObjectMeta: buildObjectMeta(cs, name),
 		Spec: certmanagerv1.IssuerSpec{
@@ -119,74 +421,246 @@ func buildIssuer(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Issuer {
 	}
 }
 
-func buildSuperAdminCertificate(cs *incloudiov1alpha1.CertificateSet, issuerName string) *certmanagerv1.Certificate {
-	name := SuperAdminName(cs)
+func buildClusterIssuer(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.ClusterIssuer {
+	return &certmanagerv1.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ClusterIssuerName(cs),
+			Labels:      childLabels(cs),
+			Annotations: copyAnnotationsForChildResource(cs.Annotations),
+		},
+		Spec: certmanagerv1.IssuerSpec{
+			IssuerConfig: certmanagerv1.IssuerConfig{
+				CA: &certmanagerv1.CAIssuer{
+					SecretName: CASecretName(cs),
+				},
+			},
+		},
+	}
+}
+
+// defaultClientUsages returns the default usages for client certificates
+func defaultClientUsages() []certmanagerv1.KeyUsage {
+	return []certmanagerv1.KeyUsage{
+		certmanagerv1.UsageClientAuth,
+		certmanagerv1.UsageDataEncipherment,
+		certmanagerv1.UsageKeyEncipherment,
+	}
+}
+
+// buildClientCertificate creates a client certificate signed by the CA issuer
+func buildClientCertificate(cs *incloudiov1alpha1.CertificateSet, issuerName, name string, organizations []string, usages []certmanagerv1.KeyUsage) *certmanagerv1.Certificate {
 	return &certmanagerv1.Certificate{
 		ObjectMeta: buildObjectMeta(cs, name),
 		Spec: certmanagerv1.CertificateSpec{
 			CommonName: name,
-			Duration:   &metav1.Duration{Duration: CertDuration1Year},
+			Duration:   clientCertDuration(cs),
 			IsCA:       false,
 			IssuerRef: cmmeta.ObjectReference{
 				Group: certmanagerv1.SchemeGroupVersion.Group,
-				Kind:  certmanagerv1.IssuerKind,
+				Kind:  clientIssuerKind(cs),
 				Name:  issuerName,
 			},
 			PrivateKey: &certmanagerv1.CertificatePrivateKey{
-				Algorithm:      certmanagerv1.RSAKeyAlgorithm,
+				Algorithm:      privateKeyAlgorithm(cs),
+				Encoding:       privateKeyEncoding(cs),
 				RotationPolicy: certmanagerv1.RotationPolicyAlways,
-				Size:           2048,
-			},
-			RenewBefore: &metav1.Duration{Duration: CertRenewBefore30Days},
-			SecretName:  name,
-			SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
-				Labels: cs.Labels,
+				Size:           leafPrivateKeySize(cs),
 			},
+			RenewBefore:    clientRenewBefore(cs),
+			SecretName:     name,
+			SecretTemplate: certificateSecretTemplate(cs),
 			Subject: &certmanagerv1.X509Subject{
-				Organizations: []string{"system:masters"},
-			},
-			Usages: []certmanagerv1.KeyUsage{
-				certmanagerv1.UsageClientAuth,
-				certmanagerv1.UsageDataEncipherment,
-				certmanagerv1.UsageKeyEncipherment,
+				Organizations: organizations,
 			},
+			Usages: usages,
 		},
 	}
 }
 
+// superAdminOrganizations returns spec.clientOrganizations, falling back to system:masters
+func superAdminOrganizations(cs *incloudiov1alpha1.CertificateSet) []string {
+	if len(cs.Spec.ClientOrganizations) > 0 {
+		return cs.Spec.ClientOrganizations
+	}
+	return []string{"system:masters"}
+}
+
+func buildSuperAdminCertificate(cs *incloudiov1alpha1.CertificateSet, issuerName string) *certmanagerv1.Certificate {
+	cert := buildClientCertificate(cs, issuerName, SuperAdminName(cs), superAdminOrganizations(cs), defaultClientUsages())
+	cert.Spec.DNSNames = cs.Spec.ClientDNSNames
+	cert.Spec.IPAddresses = cs.Spec.ClientIPAddresses
+	applySubject(cs, cert)
+	// cert-manager rejects literalSubject together with commonName or subject
+	if cs.Spec.LiteralSubject != "" {
+		cert.Spec.CommonName = ""
+		cert.Spec.Subject = nil
+		cert.Spec.LiteralSubject = cs.Spec.LiteralSubject
+	}
+	if cs.Spec.Pkcs12 && cs.Spec.Pkcs12PasswordSecretRef != nil {
+		cert.Spec.Keystores = &certmanagerv1.CertificateKeystores{
+			PKCS12: &certmanagerv1.PKCS12Keystore{
+				Create: true,
+				PasswordSecretRef: cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: cs.Spec.Pkcs12PasswordSecretRef.Name},
+					Key:                  cs.Spec.Pkcs12PasswordSecretRef.Key,
+				},
+				Profile: certmanagerv1.Modern2023PKCS12Profile,
+			},
+		}
+	}
+	return cert
+}
+
+// frontProxyClientCommonName is the user the aggregated API servers expect from the front proxy
+const frontProxyClientCommonName = "front-proxy-client"
+
+// buildFrontProxyClientCertificate creates the front-proxy client certificate signed by the Proxy Issuer,
+// presented by the API server to aggregated API servers (--proxy-client-cert-file)
+func buildFrontProxyClientCertificate(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Certificate {
+	cert := buildClientCertificate(cs, ProxyName(cs), FrontProxyClientName(cs), nil, []certmanagerv1.KeyUsage{
+		certmanagerv1.UsageClientAuth,
+		certmanagerv1.UsageDigitalSignature,
+		certmanagerv1.UsageKeyEncipherment,
+	})
+	cert.Spec.IssuerRef.Kind = "Issuer"
+	cert.Spec.CommonName = frontProxyClientCommonName
+	cert.Spec.Subject = nil
+	return cert
+}
+
+// buildETCDLeafCertificate creates an etcd server or peer certificate signed by the ETCD Issuer.
+// Both are used for serving and for client connections (etcd peers dial each other).
+func buildETCDLeafCertificate(cs *incloudiov1alpha1.CertificateSet, name string) *certmanagerv1.Certificate {
+	cert := buildClientCertificate(cs, ETCDName(cs), name, nil, []certmanagerv1.KeyUsage{
+		certmanagerv1.UsageServerAuth,
+		certmanagerv1.UsageClientAuth,
+		certmanagerv1.UsageDigitalSignature,
+		certmanagerv1.UsageKeyEncipherment,
+	})
+	cert.Spec.IssuerRef.Kind = "Issuer"
+	cert.Spec.Subject = nil
+	cert.Spec.DNSNames = cs.Spec.ETCDDNSNames
+	cert.Spec.IPAddresses = cs.Spec.ETCDIPAddresses
+	return cert
+}
+
+// buildAdditionalClientCertificate creates a Certificate for an entry of spec.clientCertificates
+func buildAdditionalClientCertificate(cs *incloudiov1alpha1.CertificateSet, issuerName string, client incloudiov1alpha1.ClientCertSpec) *certmanagerv1.Certificate {
+	usages := defaultClientUsages()
+	if len(client.Usages) > 0 {
+		usages = make([]certmanagerv1.KeyUsage, 0, len(client.Usages))
+		for _, usage := range client.Usages {
+			usages = append(usages, certmanagerv1.KeyUsage(usage))
+		}
+	}
+	return buildClientCertificate(cs, issuerName, ClientCertificateName(cs, client.Name), client.Organizations, usages)
+}
+
 func buildOIDCCertificate(cs *incloudiov1alpha1.CertificateSet) *certmanagerv1.Certificate {
 	name := CAOIDCName(cs)
 	cert := &certmanagerv1.Certificate{
 		ObjectMeta: buildObjectMeta(cs, name),
 		Spec: certmanagerv1.CertificateSpec{
-			CommonName:  name,
-			Duration:    &metav1.Duration{Duration: CertDuration20Years},
-			PrivateKey:  defaultCAPrivateKey(),
-			RenewBefore: &metav1.Duration{Duration: CertRenewBefore30Days},
-			SecretName:  name,
-			SecretTemplate: &certmanagerv1.CertificateSecretTemplate{
-				Labels: cs.Labels,
-			},
+			CommonName:     name,
+			Duration:       oidcDuration(cs),
+			PrivateKey:     defaultCAPrivateKey(cs),
+			RenewBefore:    oidcRenewBefore(cs),
+			DNSNames:       cs.Spec.OIDCDNSNames,
+			SecretName:     name,
+			SecretTemplate: certificateSecretTemplate(cs),
 		},
 	}
 
 	switch cs.Spec.Environment {
 	case incloudiov1alpha1.EnvironmentSystem:
-		gv, _ := schema.ParseGroupVersion(cs.Spec.IssuerRef.APIVersion)
 		cert.Spec.IsCA = true
-		cert.Spec.IssuerRef = cmmeta.ObjectReference{Group: gv.Group, Kind: cs.Spec.IssuerRef.Kind, Name: cs.Spec.IssuerRef.Name}
-		cert.Spec.Usages = caUsages()
+		cert.Spec.IssuerRef = issuerObjectRef(cs.Spec.IssuerRef)
+		cert.Spec.Usages = caUsages(cs)
+		applyCASubject(cs, cert)
 	case incloudiov1alpha1.EnvironmentInfra:
 		if cs.Spec.IssuerRefOidc != nil {
-			gv, _ := schema.ParseGroupVersion(cs.Spec.IssuerRefOidc.APIVersion)
 			cert.Spec.IsCA = false
-			cert.Spec.IssuerRef = cmmeta.ObjectReference{Group: gv.Group, Kind: cs.Spec.IssuerRefOidc.Kind, Name: cs.Spec.IssuerRefOidc.Name}
+			cert.Spec.IssuerRef = issuerObjectRef(*cs.Spec.IssuerRefOidc)
 		}
 	}
 
 	return cert
 }
 
+// needsSuperAdmin reports whether the super-admin certificate is needed for derived secrets
+func needsSuperAdmin(cs *incloudiov1alpha1.CertificateSet) bool {
+	return cs.Spec.Kubeconfig || cs.Spec.ArgocdCluster || cs.Spec.FullChainSecret
+}
+
+// needsClientCertificates reports whether the CA Issuer and client certificates have to be created.
+// Reconcile, checkAllResourcesReady and the Issuer cleanup all gate on it, so clientCertificates alone,
+// without the super-admin certificate, still get an Issuer.
+func needsClientCertificates(cs *incloudiov1alpha1.CertificateSet) bool {
+	return needsSuperAdmin(cs) || len(cs.Spec.ClientCertificates) > 0
+}
+
+// defaultIssuerReference fills apiVersion and kind like the CRD schema defaults do. The API server
+// applies them to issuerRef and, when present, issuerRefOidc; this covers objects that bypassed defaulting.
+func defaultIssuerReference(ref incloudiov1alpha1.IssuerReference) incloudiov1alpha1.IssuerReference {
+	if ref.APIVersion == "" {
+		ref.APIVersion = certmanagerv1.SchemeGroupVersion.String()
+	}
+	if ref.Kind == "" {
+		ref.Kind = certmanagerv1.ClusterIssuerKind
+	}
+	return ref
+}
+
+// issuerObjectRef converts a spec issuer reference into a cert-manager issuerRef
+func issuerObjectRef(ref incloudiov1alpha1.IssuerReference) cmmeta.ObjectReference {
+	ref = defaultIssuerReference(ref)
+	gv, _ := schema.ParseGroupVersion(ref.APIVersion)
+	return cmmeta.ObjectReference{Group: gv.Group, Kind: ref.Kind, Name: ref.Name}
+}
+
+// usesClusterIssuer reports whether the CA is exposed as a ClusterIssuer instead of a namespaced Issuer
+func usesClusterIssuer(cs *incloudiov1alpha1.CertificateSet) bool {
+	return cs.Spec.IssuerScope == incloudiov1alpha1.IssuerScopeClusterIssuer
+}
+
+// usesExistingCA reports whether the CA key pair is read from spec.existingCASecretRef instead of being issued
+func usesExistingCA(cs *incloudiov1alpha1.CertificateSet) bool {
+	return cs.Spec.ExistingCASecretRef != nil
+}
+
+// usesSelfSignedCA reports whether ${name}-ca is self-signed instead of issued by spec.issuerRef (client environment only)
+func usesSelfSignedCA(cs *incloudiov1alpha1.CertificateSet) bool {
+	return cs.Spec.SelfSignedCA && cs.Spec.Environment == incloudiov1alpha1.EnvironmentClient && !usesExistingCA(cs)
+}
+
+// clientIssuerKind returns the kind of the issuer signing client certificates
+func clientIssuerKind(cs *incloudiov1alpha1.CertificateSet) string {
+	if usesClusterIssuer(cs) {
+		return certmanagerv1.ClusterIssuerKind
+	}
+	return certmanagerv1.IssuerKind
+}
+
+// generateETCD reports whether the ETCD CA certificate is created (system/infra, unless disabled)
+func generateETCD(cs *incloudiov1alpha1.CertificateSet) bool {
+	return isSystemOrInfra(cs.Spec.Environment) && (cs.Spec.GenerateETCD == nil || *cs.Spec.GenerateETCD)
+}
+
+// generateETCDLeafCertificates reports whether etcd-server and etcd-peer certificates are issued from the ETCD CA
+func generateETCDLeafCertificates(cs *incloudiov1alpha1.CertificateSet) bool {
+	return generateETCD(cs) && cs.Spec.ETCDLeafCertificates
+}
+
+// generateProxy reports whether the Proxy CA certificate is created (system/infra, unless disabled)
+func generateProxy(cs *incloudiov1alpha1.CertificateSet) bool {
+	return isSystemOrInfra(cs.Spec.Environment) && (cs.Spec.GenerateProxy == nil || *cs.Spec.GenerateProxy)
+}
+
+// generateFrontProxyClientCertificate reports whether the front-proxy client certificate is issued from the Proxy CA
+func generateFrontProxyClientCertificate(cs *incloudiov1alpha1.CertificateSet) bool {
+	return generateProxy(cs) && cs.Spec.FrontProxyClientCertificate
+}
+
 func isSystemOrInfra(environment incloudiov1alpha1.EnvironmentType) bool {
 	return environment == incloudiov1alpha1.EnvironmentSystem || environment == incloudiov1alpha1.EnvironmentInfra
 }
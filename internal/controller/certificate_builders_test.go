@@ -0,0 +1,239 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+var _ = Describe("Literal subject", func() {
+	DescribeTable("accepts RFC 4514 subjects",
+		func(subject string) {
+			Expect(validateLiteralSubject(subject)).To(Succeed())
+		},
+		Entry("fixed RDN order", "CN=admin,OU=platform,O=system:masters,C=DE"),
+		Entry("multi-valued RDN", "CN=admin+UID=42,O=example"),
+		Entry("escaped separators", `CN=Doe\, John,O=Example\+Co`),
+		Entry("hex escape", `CN=caf\C3\A9`),
+		Entry("numeric OID", "CN=admin,2.5.4.10=example"),
+	)
+
+	DescribeTable("rejects invalid subjects",
+		func(subject string) {
+			err := validateLiteralSubject(subject)
+			Expect(err).To(MatchError(ErrInvalidLiteralSubject))
+			Expect(reasonForError(err, "ClientCertificatesFailed")).To(Equal("InvalidLiteralSubject"))
+		},
+		Entry("missing CN", "O=system:masters"),
+		Entry("missing equals sign", "CN=admin,system:masters"),
+		Entry("unknown attribute type", "CN=admin,EMAIL=admin@example.com"),
+		Entry("lowercase attribute type", "cn=admin"),
+		Entry("unescaped special character", "CN=a<b"),
+		Entry("dangling escape", `CN=admin\`),
+		Entry("invalid hex value", "CN=admin,O=#zz"),
+	)
+
+	It("replaces the structured subject of the super-admin certificate", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:    incloudiov1alpha1.EnvironmentClient,
+				Kubeconfig:     true,
+				LiteralSubject: "CN=admin,O=system:masters",
+			},
+		}
+
+		cert := buildSuperAdminCertificate(cs, CAName(cs))
+		Expect(cert.Spec.LiteralSubject).To(Equal("CN=admin,O=system:masters"))
+		Expect(cert.Spec.CommonName).To(BeEmpty())
+		Expect(cert.Spec.Subject).To(BeNil())
+	})
+})
+
+var _ = Describe("Front-proxy client certificate", func() {
+	It("is issued by the Proxy Issuer with the front-proxy-client common name", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:                 incloudiov1alpha1.EnvironmentSystem,
+				FrontProxyClientCertificate: true,
+			},
+		}
+
+		cert := buildFrontProxyClientCertificate(cs)
+		Expect(cert.Name).To(Equal("demo-front-proxy-client"))
+		Expect(cert.Spec.CommonName).To(Equal("front-proxy-client"))
+		Expect(cert.Spec.IssuerRef.Kind).To(Equal("Issuer"))
+		Expect(cert.Spec.IssuerRef.Name).To(Equal(ProxyName(cs)))
+		Expect(cert.Spec.Usages).To(ContainElement(certmanagerv1.UsageClientAuth))
+		Expect(AllCertificateNames(cs)).To(ContainElement("demo-front-proxy-client"))
+
+		disabled := false
+		cs.Spec.GenerateProxy = &disabled
+		Expect(AllCertificateNames(cs)).NotTo(ContainElement("demo-front-proxy-client"))
+	})
+})
+
+var _ = Describe("Issuer scope", func() {
+	It("signs client certificates through a namespace-prefixed ClusterIssuer when issuerScope is ClusterIssuer", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "team"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentClient,
+				Kubeconfig:  true,
+			},
+		}
+		Expect(clientIssuerKind(cs)).To(Equal(certmanagerv1.IssuerKind))
+
+		cs.Spec.IssuerScope = incloudiov1alpha1.IssuerScopeClusterIssuer
+		Expect(clientIssuerKind(cs)).To(Equal(certmanagerv1.ClusterIssuerKind))
+
+		issuer := buildClusterIssuer(cs)
+		Expect(issuer.Name).To(Equal("team-demo-ca"))
+		Expect(issuer.Namespace).To(BeEmpty())
+		Expect(issuer.Spec.CA.SecretName).To(Equal(CASecretName(cs)))
+
+		superAdmin := buildSuperAdminCertificate(cs, issuer.Name)
+		Expect(superAdmin.Spec.IssuerRef.Kind).To(Equal(certmanagerv1.ClusterIssuerKind))
+		Expect(superAdmin.Spec.IssuerRef.Name).To(Equal("team-demo-ca"))
+	})
+})
+
+var _ = Describe("Label propagation", func() {
+	It("copies no CertificateSet labels to children when propagateLabels is false", func() {
+		disabled := false
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "demo",
+				Namespace: "default",
+				Labels:    map[string]string{"app.kubernetes.io/instance": "tenant"},
+			},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:  incloudiov1alpha1.EnvironmentClient,
+				SecretLabels: map[string]string{"team": "a"},
+			},
+		}
+		Expect(buildIssuer(cs).Labels).To(HaveKeyWithValue("app.kubernetes.io/instance", "tenant"))
+
+		cs.Spec.PropagateLabels = &disabled
+		Expect(buildIssuer(cs).Labels).To(BeEmpty())
+		Expect(buildCACertificate(cs).Spec.SecretTemplate.Labels).To(BeEmpty())
+		Expect(derivedSecretLabels(cs)).To(HaveKeyWithValue("team", "a"))
+		Expect(derivedSecretLabels(cs)).NotTo(HaveKey("app.kubernetes.io/instance"))
+	})
+})
+
+var _ = Describe("CA common name", func() {
+	It("overrides only the CN of the CA certificate", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:  incloudiov1alpha1.EnvironmentSystem,
+				CACommonName: "Acme Cluster Root CA",
+			},
+		}
+
+		cert := buildCACertificate(cs)
+		Expect(cert.Name).To(Equal("demo-ca"))
+		Expect(cert.Spec.SecretName).To(Equal("demo-ca"))
+		Expect(cert.Spec.CommonName).To(Equal("Acme Cluster Root CA"))
+		Expect(buildProxyCertificate(cs).Spec.CommonName).To(Equal(ProxyName(cs)))
+
+		cs.Spec.CACommonName = ""
+		Expect(buildCACertificate(cs).Spec.CommonName).To(Equal("demo-ca"))
+	})
+})
+
+var _ = Describe("Existing CA Secret", func() {
+	It("backs the CA issuer with the referenced Secret and issues no CA Certificate", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:         incloudiov1alpha1.EnvironmentClient,
+				Kubeconfig:          true,
+				ExistingCASecretRef: &incloudiov1alpha1.SecretReference{Name: "corp-ca"},
+			},
+		}
+
+		issuer := buildIssuer(cs)
+		Expect(issuer.Name).To(Equal("demo-ca"))
+		Expect(issuer.Spec.CA.SecretName).To(Equal("corp-ca"))
+		Expect(buildClusterIssuer(cs).Spec.CA.SecretName).To(Equal("corp-ca"))
+		Expect(AllCertificateNames(cs)).NotTo(ContainElement("demo-ca"))
+
+		cs.Spec.ExistingCASecretRef = nil
+		Expect(buildIssuer(cs).Spec.CA.SecretName).To(Equal("demo-ca"))
+		Expect(AllCertificateNames(cs)).To(ContainElement("demo-ca"))
+	})
+})
+
+var _ = Describe("OIDC certificate duration", func() {
+	It("uses oidcDuration and oidcRenewBefore, falling back to 20 years and renewBefore", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentSystem,
+				RenewBefore: &metav1.Duration{Duration: 48 * time.Hour},
+			},
+		}
+
+		cert := buildOIDCCertificate(cs)
+		Expect(cert.Spec.Duration.Duration).To(Equal(CertDuration20Years))
+		Expect(cert.Spec.RenewBefore.Duration).To(Equal(48 * time.Hour))
+
+		cs.Spec.OIDCDuration = &metav1.Duration{Duration: 90 * 24 * time.Hour}
+		cs.Spec.OIDCRenewBefore = &metav1.Duration{Duration: 15 * 24 * time.Hour}
+		cert = buildOIDCCertificate(cs)
+		Expect(cert.Spec.Duration.Duration).To(Equal(90 * 24 * time.Hour))
+		Expect(cert.Spec.RenewBefore.Duration).To(Equal(15 * 24 * time.Hour))
+		Expect(buildCACertificate(cs).Spec.Duration.Duration).To(Equal(CertDuration20Years))
+	})
+
+	It("adds oidcDNSNames only when set", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec:       incloudiov1alpha1.CertificateSetSpec{Environment: incloudiov1alpha1.EnvironmentSystem},
+		}
+		Expect(buildOIDCCertificate(cs).Spec.DNSNames).To(BeEmpty())
+
+		cs.Spec.OIDCDNSNames = []string{"oidc.example.com"}
+		Expect(buildOIDCCertificate(cs).Spec.DNSNames).To(Equal([]string{"oidc.example.com"}))
+	})
+})
+
+var _ = Describe("Private key encoding", func() {
+	It("is left to the cert-manager default unless set", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec:       incloudiov1alpha1.CertificateSetSpec{Environment: incloudiov1alpha1.EnvironmentClient, Kubeconfig: true},
+		}
+		Expect(buildCACertificate(cs).Spec.PrivateKey.Encoding).To(BeEmpty())
+		Expect(buildSuperAdminCertificate(cs, CAName(cs)).Spec.PrivateKey.Encoding).To(BeEmpty())
+
+		cs.Spec.PrivateKeyEncoding = incloudiov1alpha1.PrivateKeyEncodingPKCS8
+		Expect(buildCACertificate(cs).Spec.PrivateKey.Encoding).To(Equal(certmanagerv1.PKCS8))
+		Expect(buildSuperAdminCertificate(cs, CAName(cs)).Spec.PrivateKey.Encoding).To(Equal(certmanagerv1.PKCS8))
+	})
+})
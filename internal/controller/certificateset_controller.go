@@ -17,18 +17,36 @@ limitations under the License.
 package controller
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
 	"time"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	incloudiov1alpha1 "certificate-set/api/v1alpha1"
 )
@@ -38,15 +56,62 @@ const (
 	ConditionTypeReady       = "Ready"
 	ConditionTypeProgressing = "Progressing"
 	ConditionTypeDegraded    = "Degraded"
+	ConditionTypePaused      = "Paused"
 
-	// Finalizer for cross-namespace resource cleanup
-	finalizerName = "certificateset.in-cloud.io/cleanup"
+	// Per-certificate conditions of additional client certificates are named with this prefix and the client name
+	clientCertificateConditionPrefix = "ClientCertificateReady-"
 
-	// ArgoCDNamespace is the namespace where ArgoCD cluster secrets are created
-	ArgoCDNamespace = "beget-argocd"
+	// DefaultFinalizerName is the finalizer for cross-namespace resource cleanup
+	DefaultFinalizerName = "certificateset.in-cloud.io/cleanup"
+
+	// DefaultArgoCDNamespace is the namespace where ArgoCD cluster secrets are created
+	// unless spec.argocdNamespace is set
+	DefaultArgoCDNamespace = "beget-argocd"
+
+	// DryRunAnnotation makes Reconcile only report planned resources in status
+	DryRunAnnotation = "certificateset.in-cloud.io/dry-run"
+
+	// AdoptAnnotation allows Reconcile to take over existing Certificates and Issuers with matching
+	// names that are not owned by this CertificateSet
+	AdoptAnnotation = "certificateset.in-cloud.io/adopt"
+
+	// SkipFinalizerAnnotation set to "true" skips the cleanup finalizer when no cross-namespace or
+	// cluster-scoped resource is managed, so deletion is not blocked while the controller is down
+	SkipFinalizerAnnotation = "certificateset.in-cloud.io/skip-finalizer"
+
+	// Owner labels mark resources in spec.targetNamespace, where OwnerReferences cannot point to the CertificateSet
+	OwnerNameLabel      = "certificateset.in-cloud.io/owner-name"
+	OwnerNamespaceLabel = "certificateset.in-cloud.io/owner-namespace"
+
+	// PausedAnnotation set to "true" stops Reconcile from creating, updating or deleting anything for the CertificateSet
+	PausedAnnotation = "certificateset.in-cloud.io/paused"
+
+	// RotateCAAnnotation re-creates the CA with a new key whenever its value changes
+	RotateCAAnnotation = "certificateset.in-cloud.io/rotate-ca"
+
+	// ResyncAnnotation rewrites the managed keys of every derived Secret whenever its value changes,
+	// even if they look unchanged
+	ResyncAnnotation = "certificateset.in-cloud.io/resync"
 
 	// Requeue intervals
-	defaultRequeueAfter = 5 * time.Second
+	// DefaultRequeueInterval is the requeue delay of waiting branches unless RequeueInterval overrides it
+	DefaultRequeueInterval = 5 * time.Second
+	// Cap of the backoff while waiting for cert-manager Secrets. Reconciliation is normally triggered
+	// by the Secret watch, the requeue is a backstop that grows per object from the requeue interval.
+	secretWaitBackoffMax = 5 * time.Minute
+	// DefaultClusterResourceNamespace is the default --cluster-resource-namespace of cert-manager
+	DefaultClusterResourceNamespace = "cert-manager"
+
+	// Delay before retrying ArgoCD Secrets while their namespace is terminating
+	argoCDNamespaceRequeueAfter = 30 * time.Second
+
+	// Event reasons
+	EventReasonCASecretReady = "CASecretReady"
+	EventReasonSecretCreated = "SecretCreated"
+	EventReasonSecretUpdated = "SecretUpdated"
+	EventReasonCARotated     = "CARotated"
+	EventReasonCAKeyRotation = "CAKeyRotationAlways"
+	EventReasonResynced      = "Resynced"
 )
 
 // CertificateSetReconciler reconciles a CertificateSet object
@@ -54,6 +119,36 @@ type CertificateSetReconciler struct {
 	client.Client
 	Scheme    *runtime.Scheme
 	APIReader client.Reader // Non-caching reader for direct API server reads
+	Recorder  record.EventRecorder
+
+	// RequireCertificateReady makes derived Secrets wait for Ready=True on the super-admin Certificate,
+	// not only for its Secret, so a kubeconfig is never rendered from a Secret in the middle of re-issuance
+	RequireCertificateReady bool
+
+	// FinalizerName overrides DefaultFinalizerName
+	FinalizerName string
+
+	// MaxConcurrentReconciles is the number of CertificateSets reconciled in parallel (default 1).
+	// The reconciler keeps no per-call state outside the object being reconciled, so any value is safe.
+	MaxConcurrentReconciles int
+
+	// ReconcileTimeout bounds a single reconciliation, so a slow API server cannot tie up a worker (0 disables it).
+	// A timed out reconciliation returns an error and is requeued with rate-limited backoff.
+	ReconcileTimeout time.Duration
+
+	// RequeueInterval is the delay before a waiting reconciliation is retried and the start of the
+	// backoff while waiting for cert-manager Secrets (default DefaultRequeueInterval)
+	RequeueInterval time.Duration
+
+	// DisableArgoCD rejects CertificateSets with spec.argocdCluster and skips the ArgoCD namespace lookup and cleanup
+	DisableArgoCD bool
+
+	// ClusterResourceNamespace is the --cluster-resource-namespace of cert-manager, where a CA ClusterIssuer
+	// looks up its Secret (default DefaultClusterResourceNamespace)
+	ClusterResourceNamespace string
+
+	// secretWaitBackoff tracks requeue delays while waiting for cert-manager Secrets
+	secretWaitBackoff requeueBackoff
 }
 
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
@@ -62,41 +157,94 @@ type CertificateSetReconciler struct {
 // +kubebuilder:rbac:groups=in-cloud.io,resources=certificatesets/finalizers,verbs=update
 // +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=cert-manager.io,resources=issuers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=clusterissuers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile implements the reconciliation loop for CertificateSet resources.
 //
 // The reconciliation flow:
 //  1. Create CA certificates (CA, and ETCD/Proxy/OIDC for system/infra environments)
 //  2. Wait for CA Secret to be created by cert-manager
-//  3. If kubeconfig or argocd is enabled:
-//     - Create Issuer and client certificates (super-admin)
+//  3. If kubeconfig, argocd or additional client certificates are enabled:
+//     - Create Issuer and client certificates (super-admin, spec.clientCertificates)
+//     - Create kubeconfig Secrets for issued additional client certificates
 //     - Wait for super-admin Secret to be created by cert-manager
 //     - Create derived secrets (kubeconfig, ArgoCD cluster)
 //  4. Verify all resources are Ready
 //  5. Update status conditions
+//
+// Readiness metrics are updated whenever the status is patched; failed reconciliations are counted here.
 func (r *CertificateSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	ctx, span := tracer.Start(ctx, "Reconcile", trace.WithAttributes(
+		attribute.String("certificateset.name", req.Name),
+		attribute.String("certificateset.namespace", req.Namespace),
+	))
+	if r.ReconcileTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.ReconcileTimeout)
+		defer cancel()
+	}
+	result, err := r.reconcile(ctx, req)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err = fmt.Errorf("reconcile timed out after %s: %w", r.ReconcileTimeout, err)
+	}
+	if err != nil {
+		certificateSetReconcileErrors.Inc()
+	}
+	endSpan(span, err)
+	return result, err
+}
+
+// reconcile runs a single reconciliation of the CertificateSet, see Reconcile
+func (r *CertificateSetReconciler) reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
 	// Fetch the CertificateSet resource
 	cs := &incloudiov1alpha1.CertificateSet{}
 	if err := r.Get(ctx, req.NamespacedName, cs); err != nil {
 		if apierrors.IsNotFound(err) {
+			r.secretWaitBackoff.forget(req.NamespacedName)
+			forgetStatusMetrics(req.NamespacedName)
 			log.Info("CertificateSet resource not found, ignoring")
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, err
 	}
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("certificateset.environment", string(cs.Spec.Environment)))
+
+	// Paused - freeze the CertificateSet and its resources, including finalizer cleanup, until the annotation is removed
+	if cs.Annotations[PausedAnnotation] == "true" {
+		return r.reconcilePaused(ctx, cs)
+	}
 
-	// Handle deletion - clean up cross-namespace resources
+	// Handle deletion - clean up cross-namespace resources while our finalizer holds the object
 	if !cs.DeletionTimestamp.IsZero() {
+		if !controllerutil.ContainsFinalizer(cs, r.finalizer()) {
+			return ctrl.Result{}, nil
+		}
 		return r.reconcileDelete(ctx, cs)
 	}
 
-	// Add finalizer if not present (needed for cross-namespace ArgoCD Secret cleanup)
-	if !controllerutil.ContainsFinalizer(cs, finalizerName) {
-		log.Info("Adding finalizer", "finalizer", finalizerName)
-		controllerutil.AddFinalizer(cs, finalizerName)
+	// Dry-run - report planned resources without creating anything
+	if cs.Annotations[DryRunAnnotation] == "true" {
+		return r.reconcileDryRun(ctx, cs)
+	}
+
+	// Add finalizer if not present (needed for cross-namespace ArgoCD Secret and ClusterIssuer cleanup),
+	// or drop it once the CertificateSet opts out
+	if skipFinalizer(cs) {
+		if controllerutil.RemoveFinalizer(cs, r.finalizer()) {
+			log.Info("Removing finalizer", "finalizer", r.finalizer())
+			if err := r.Update(ctx, cs); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{Requeue: true}, nil
+		}
+	} else if !controllerutil.ContainsFinalizer(cs, r.finalizer()) {
+		log.Info("Adding finalizer", "finalizer", r.finalizer())
+		controllerutil.AddFinalizer(cs, r.finalizer())
 		if err := r.Update(ctx, cs); err != nil {
 			return ctrl.Result{}, err
 		}
@@ -108,53 +256,279 @@ func (r *CertificateSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// Save original status for patch comparison
 	csOriginal := cs.DeepCopy()
+	cs.Status.PlannedResources = nil
+	meta.RemoveStatusCondition(&cs.Status.Conditions, ConditionTypePaused)
+
+	// cert-manager CRDs must be installed; otherwise every create fails with an opaque "no matches for kind"
+	if err := r.checkCertManagerInstalled(); err != nil {
+		if !errors.Is(err, ErrCertManagerMissing) {
+			return ctrl.Result{}, err
+		}
+		log.Info("cert-manager CRDs are not installed", "error", err.Error())
+		r.setDegraded(cs, "CertManagerMissing", err.Error())
+		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+			return ctrl.Result{}, patchErr
+		}
+		return ctrl.Result{RequeueAfter: r.secretWaitBackoff.next(req.NamespacedName, waitStepCertManager, r.requeueInterval(), secretWaitBackoffMax)}, nil
+	}
+	r.secretWaitBackoff.reset(req.NamespacedName, waitStepCertManager)
+
+	if r.DisableArgoCD && cs.Spec.ArgocdCluster {
+		msg := "ArgoCD integration is disabled in the controller (--enable-argocd=false); set spec.argocdCluster to false"
+		log.Info("Rejecting CertificateSet with argocdCluster enabled")
+		r.Recorder.Event(cs, corev1.EventTypeWarning, "ArgoCDDisabled", msg)
+		r.setDegraded(cs, "ArgoCDDisabled", msg)
+		// Retrying does not help; changing the spec triggers a new reconciliation
+		return ctrl.Result{}, r.patchStatus(ctx, cs, csOriginal)
+	}
+
+	// Labels are copied to every child resource; an invalid one would fail each create with an opaque error
+	if err := validateChildLabels(cs); err != nil {
+		log.Info("CertificateSet has invalid labels for child resources", "error", err.Error())
+		r.Recorder.Event(cs, corev1.EventTypeWarning, "InvalidLabels", err.Error())
+		r.setDegraded(cs, "InvalidLabels", err.Error())
+		// Retrying does not help; fixing the labels triggers a new reconciliation
+		return ctrl.Result{}, r.patchStatus(ctx, cs, csOriginal)
+	}
+
+	// On-demand CA rotation: drop the CA and the certificates it signed, Step 1 re-creates them
+	// An existing CA is not issued by the operator, so there is nothing to rotate
+	if rotation := cs.Annotations[RotateCAAnnotation]; rotation != "" && rotation != cs.Status.LastCARotation && !usesExistingCA(cs) {
+		if err := r.rotateCA(ctx, cs); err != nil {
+			log.Error(err, "CA rotation failed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, "CARotationFailed", err.Error())
+			r.setDegraded(cs, "CARotationFailed", err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after CA rotation error")
+			}
+			return ctrl.Result{}, err
+		}
+		cs.Status.LastCARotation = rotation
+		r.Recorder.Event(cs, corev1.EventTypeWarning, EventReasonCARotated, fmt.Sprintf(
+			"CA %s is being re-issued with a new key (rotate-ca=%s); kubeconfigs and clients trusting the old CA stop working",
+			CAName(cs), rotation))
+	}
+
+	// Reported once per spec generation, not on every reconcile
+	if cs.Spec.CARotationPolicy == incloudiov1alpha1.CARotationPolicyAlways {
+		if ready := meta.FindStatusCondition(cs.Status.Conditions, ConditionTypeReady); ready == nil || ready.ObservedGeneration != cs.Generation {
+			r.Recorder.Event(cs, corev1.EventTypeWarning, EventReasonCAKeyRotation,
+				"caRotationPolicy is Always: every CA renewal generates a new key and re-issues all certificates signed by it")
+		}
+	}
 
 	// Step 1: Create all CA certificates (CA, and ETCD/Proxy/OIDC for system/infra)
+	cs.Status.Phase = incloudiov1alpha1.PhaseCreatingCA
 	if err := r.reconcileCACertificates(ctx, cs); err != nil {
 		log.Error(err, "CA certificates creation failed")
-		r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionTrue, "CACertificatesFailed", err.Error())
+		reason := reasonForError(err, "CACertificatesFailed")
+		r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+		r.setDegraded(cs, reason, err.Error())
 		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
 			log.Error(patchErr, "Failed to patch status after CA creation error")
 		}
 		return ctrl.Result{}, err
 	}
 
-	// Step 2: Wait for CA Secret to be created by cert-manager
-	caSecretReady, err := r.isSecretReady(ctx, cs.Namespace, CAName(cs))
+	// Step 2: Wait for CA Secret to be created by cert-manager, or check the existing CA Secret
+	if usesExistingCA(cs) {
+		if err := r.checkExistingCASecret(ctx, cs); err != nil {
+			log.Error(err, "Existing CA Secret is not usable")
+			reason := reasonForError(err, "CACertificatesFailed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+			r.setDegraded(cs, reason, err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after existing CA Secret error")
+			}
+			return ctrl.Result{}, err
+		}
+	}
+	waitCtx, waitSpan := tracer.Start(ctx, "waitForCASecret")
+	caSecretReady, err := r.isSecretReady(waitCtx, TargetNamespace(cs), CASecretName(cs))
+	waitSpan.SetAttributes(attribute.Bool("ready", caSecretReady))
+	endSpan(waitSpan, err)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 	if !caSecretReady {
 		log.Info("Waiting for CA Secret to be created by cert-manager")
-		return ctrl.Result{RequeueAfter: defaultRequeueAfter}, nil
+		msg := fmt.Sprintf("Waiting for Secret %s to be created by cert-manager", CAName(cs))
+		r.setCondition(cs, ConditionTypeReady, metav1.ConditionFalse, "WaitingForResources", msg)
+		r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionTrue, "WaitingForCASecret", msg)
+		cs.Status.Phase = incloudiov1alpha1.PhaseWaitingForCASecret
+		r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionFalse, "Healthy", "No errors")
+		if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.secretWaitBackoff.next(req.NamespacedName, waitStepCASecret, r.requeueInterval(), secretWaitBackoffMax)}, nil
+	}
+	r.secretWaitBackoff.reset(req.NamespacedName, waitStepCASecret)
+	if progressing := meta.FindStatusCondition(cs.Status.Conditions, ConditionTypeProgressing); progressing != nil && progressing.Reason == "WaitingForCASecret" {
+		msg := fmt.Sprintf("CA Secret %s is ready", CAName(cs))
+		r.Recorder.Event(cs, corev1.EventTypeNormal, EventReasonCASecretReady, msg)
+		r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionTrue, "CASecretReady", msg)
 	}
 
-	// Step 3: Create client certificates if kubeconfig or argocd is enabled
-	needsClientCerts := cs.Spec.Kubeconfig || cs.Spec.ArgocdCluster
-	if needsClientCerts {
-		// Create Issuer and super-admin certificate
+	// Publish the CA trust bundle, or remove it once the flag is turned off
+	if cs.Spec.PublishCABundle {
+		if err := r.reconcileCABundle(ctx, cs); err != nil {
+			log.Error(err, "CA bundle Secret creation failed")
+			reason := reasonForError(err, "DerivedSecretsFailed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+			r.setDegraded(cs, reason, err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after CA bundle error")
+			}
+			return ctrl.Result{}, err
+		}
+	} else if err := r.cleanupCABundleSecret(ctx, cs); err != nil {
+		log.Error(err, "Failed to delete CA bundle secret")
+		r.setDegraded(cs, "CABundleCleanupFailed", err.Error())
+		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+			log.Error(patchErr, "Failed to patch status after CA bundle cleanup error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Publish the JKS truststore, or remove it once the flag is turned off
+	if cs.Spec.JksCABundle {
+		if err := r.reconcileCAJKS(ctx, cs); err != nil {
+			log.Error(err, "JKS truststore Secret creation failed")
+			reason := reasonForError(err, "DerivedSecretsFailed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+			r.setDegraded(cs, reason, err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after JKS truststore error")
+			}
+			return ctrl.Result{}, err
+		}
+	} else if err := r.cleanupCAJKSSecret(ctx, cs); err != nil {
+		log.Error(err, "Failed to delete JKS truststore secret")
+		r.setDegraded(cs, "CAJKSCleanupFailed", err.Error())
+		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+			log.Error(patchErr, "Failed to patch status after JKS truststore cleanup error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Publish the CA certificate for caBundle injection; the ConfigMap is removed with the stale resources once disabled
+	if cs.Spec.PublishCAConfigMap {
+		if err := r.reconcileCACertConfigMap(ctx, cs); err != nil {
+			log.Error(err, "CA certificate ConfigMap creation failed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, "CACertConfigMapFailed", err.Error())
+			r.setDegraded(cs, "CACertConfigMapFailed", err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after CA certificate ConfigMap error")
+			}
+			return ctrl.Result{}, err
+		}
+	}
+
+	// etcd-server and etcd-peer certificates signed by the ETCD CA
+	if generateETCDLeafCertificates(cs) {
+		if err := r.reconcileETCDLeafCertificates(ctx, cs); err != nil {
+			log.Error(err, "etcd leaf certificates creation failed")
+			reason := reasonForError(err, "ETCDCertificatesFailed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+			r.setDegraded(cs, reason, err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after etcd certificates error")
+			}
+			return ctrl.Result{}, err
+		}
+	}
+
+	// front-proxy client certificate signed by the Proxy CA
+	if generateFrontProxyClientCertificate(cs) {
+		if err := r.reconcileFrontProxyClientCertificate(ctx, cs); err != nil {
+			log.Error(err, "front-proxy client certificate creation failed")
+			reason := reasonForError(err, "FrontProxyCertificateFailed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+			r.setDegraded(cs, reason, err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after front-proxy client certificate error")
+			}
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Step 3: Create client certificates if kubeconfig, argocd or additional client certificates are enabled
+	if needsClientCertificates(cs) {
+		// Create Issuer, super-admin and additional client certificates
+		cs.Status.Phase = incloudiov1alpha1.PhaseCreatingClientCerts
 		if err := r.reconcileClientCertificates(ctx, cs); err != nil {
+			if errors.Is(err, ErrInvalidLiteralSubject) {
+				r.Recorder.Event(cs, corev1.EventTypeWarning, "InvalidLiteralSubject", err.Error())
+				r.setDegraded(cs, "InvalidLiteralSubject", err.Error())
+				// Retrying does not help; changing the spec triggers a new reconciliation
+				return ctrl.Result{}, r.patchStatus(ctx, cs, csOriginal)
+			}
 			log.Error(err, "Client certificates creation failed")
-			r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionTrue, "ClientCertificatesFailed", err.Error())
+			reason := reasonForError(err, "ClientCertificatesFailed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+			r.setDegraded(cs, reason, err.Error())
 			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
 				log.Error(patchErr, "Failed to patch status after client certificates error")
 			}
 			return ctrl.Result{}, err
 		}
 
+		// Kubeconfig Secrets for additional client certificates don't block the super-admin flow
+		if err := r.reconcileClientKubeconfigs(ctx, cs); err != nil {
+			log.Error(err, "Client kubeconfig secrets creation failed")
+			reason := reasonForError(err, "DerivedSecretsFailed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+			r.setDegraded(cs, reason, err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after client kubeconfig secrets error")
+			}
+			return ctrl.Result{}, err
+		}
+	}
+
+	if needsSuperAdmin(cs) {
 		// Step 4: Wait for super-admin Secret to be created by cert-manager
 		superAdminSecretName := SuperAdminName(cs)
-		superAdminReady, err := r.isSecretReady(ctx, cs.Namespace, superAdminSecretName)
+		superAdminReady, err := r.isSecretReady(ctx, TargetNamespace(cs), superAdminSecretName)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 		if !superAdminReady {
 			log.Info("Waiting for super-admin Secret to be created by cert-manager")
-			return ctrl.Result{RequeueAfter: defaultRequeueAfter}, nil
+			msg := fmt.Sprintf("Waiting for Secret %s to be created by cert-manager", superAdminSecretName)
+			r.setCondition(cs, ConditionTypeReady, metav1.ConditionFalse, "WaitingForResources", msg)
+			r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionTrue, "WaitingForSuperAdminSecret", msg)
+			cs.Status.Phase = incloudiov1alpha1.PhaseWaitingForClientSecret
+			r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionFalse, "Healthy", "No errors")
+			if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.secretWaitBackoff.next(req.NamespacedName, waitStepSuperAdminSecret, r.requeueInterval(), secretWaitBackoffMax)}, nil
+		}
+		r.secretWaitBackoff.reset(req.NamespacedName, waitStepSuperAdminSecret)
+		if r.RequireCertificateReady {
+			status, reason, message, err := r.getCertificateReadyCondition(ctx, TargetNamespace(cs), superAdminSecretName)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if status != metav1.ConditionTrue {
+				log.Info("Waiting for super-admin Certificate to become Ready", "reason", reason)
+				msg := fmt.Sprintf("Waiting for Certificate %s to become Ready: %s", superAdminSecretName, message)
+				r.setCondition(cs, ConditionTypeReady, metav1.ConditionFalse, "WaitingForResources", msg)
+				r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionTrue, "WaitingForSuperAdminCertificate", msg)
+				cs.Status.Phase = incloudiov1alpha1.PhaseWaitingForClientSecret
+				r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionFalse, "Healthy", "No errors")
+				if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{RequeueAfter: r.secretWaitBackoff.next(req.NamespacedName, waitStepSuperAdminCertificate, r.requeueInterval(), secretWaitBackoffMax)}, nil
+			}
 		}
+		r.secretWaitBackoff.reset(req.NamespacedName, waitStepSuperAdminCertificate)
 
-		// Get certificate data from super-admin Secret
-		certData, err := r.getCertificateData(ctx, cs.Namespace, superAdminSecretName)
+		// Get certificate data from super-admin Secret. It is read on every reconcile,
+		// so a rotated super-admin key is propagated into the derived secrets.
+		certData, err := r.getCertificateData(ctx, TargetNamespace(cs), superAdminSecretName)
 		if err != nil {
 			log.Error(err, "Failed to get certificate data from super-admin Secret")
 			return ctrl.Result{}, err
@@ -162,8 +536,33 @@ func (r *CertificateSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 		// Step 5: Create derived secrets (kubeconfig, ArgoCD cluster)
 		if err := r.reconcileDerivedSecrets(ctx, cs, certData); err != nil {
+			if errors.Is(err, ErrMissingEndpoint) {
+				log.Info("Skipping derived secrets: kubeconfigEndpoint is empty")
+				r.Recorder.Event(cs, corev1.EventTypeWarning, "MissingEndpoint", err.Error())
+				r.setDegraded(cs, "MissingEndpoint", err.Error())
+				// Retrying does not help; changing the spec triggers a new reconciliation
+				return ctrl.Result{}, r.patchStatus(ctx, cs, csOriginal)
+			}
+			if errors.Is(err, ErrSecretTypeImmutable) {
+				r.Recorder.Event(cs, corev1.EventTypeWarning, "SecretTypeImmutable", err.Error())
+				r.setDegraded(cs, "SecretTypeImmutable", err.Error())
+				// Retrying does not help; deleting the Secret or changing the spec triggers a new reconciliation
+				return ctrl.Result{}, r.patchStatus(ctx, cs, csOriginal)
+			}
+			if errors.Is(err, ErrArgoCDNamespaceTerminating) {
+				// Namespace teardown takes a while; requeue instead of failing in a tight loop
+				log.Info("ArgoCD namespace is terminating, retrying later", "error", err.Error())
+				r.Recorder.Event(cs, corev1.EventTypeWarning, "ArgoCDNamespaceTerminating", err.Error())
+				r.setDegraded(cs, "ArgoCDNamespaceTerminating", err.Error())
+				if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
+					return ctrl.Result{}, err
+				}
+				return ctrl.Result{RequeueAfter: argoCDNamespaceRequeueAfter}, nil
+			}
 			log.Error(err, "Derived secrets creation failed")
-			r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionTrue, "DerivedSecretsFailed", err.Error())
+			reason := reasonForError(err, "DerivedSecretsFailed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+			r.setDegraded(cs, reason, err.Error())
 			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
 				log.Error(patchErr, "Failed to patch status after derived secrets error")
 			}
@@ -171,11 +570,59 @@ func (r *CertificateSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		}
 	}
 
-	if !cs.Spec.ArgocdCluster {
-		argocdSecretName := ArgoCDClusterName(cs)
-		if err := r.deleteSecretIfExists(ctx, ArgoCDNamespace, argocdSecretName); err != nil {
+	// Publish the etcd, Proxy and OIDC CA bundles, or remove them once they are no longer wanted
+	if err := r.reconcileComponentCABundles(ctx, cs); err != nil {
+		log.Error(err, "Component CA bundle Secret creation failed")
+		reason := reasonForError(err, "DerivedSecretsFailed")
+		r.Recorder.Event(cs, corev1.EventTypeWarning, reason, err.Error())
+		r.setDegraded(cs, reason, err.Error())
+		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+			log.Error(patchErr, "Failed to patch status after component CA bundle error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Every derived Secret has been written by now, so the resync request is done
+	if resyncRequested(cs) {
+		cs.Status.LastResync = cs.Annotations[ResyncAnnotation]
+		r.Recorder.Event(cs, corev1.EventTypeNormal, EventReasonResynced,
+			fmt.Sprintf("Derived Secrets were rewritten (resync=%s)", cs.Status.LastResync))
+	}
+
+	if cs.Spec.OIDCCABundleConfigMap != "" {
+		if err := r.reconcileOIDCCABundle(ctx, cs); err != nil {
+			log.Error(err, "OIDC CA bundle ConfigMap creation failed")
+			r.Recorder.Event(cs, corev1.EventTypeWarning, "OIDCCABundleFailed", err.Error())
+			r.setDegraded(cs, "OIDCCABundleFailed", err.Error())
+			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+				log.Error(patchErr, "Failed to patch status after OIDC CA bundle error")
+			}
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := r.cleanupStaleClientCertificates(ctx, cs); err != nil {
+		log.Error(err, "Failed to delete stale client certificates")
+		r.setDegraded(cs, "ClientCertificatesCleanupFailed", err.Error())
+		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+			log.Error(patchErr, "Failed to patch status after client certificates cleanup error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	if err := r.cleanupOrphanedResources(ctx, cs); err != nil {
+		log.Error(err, "Failed to delete orphaned resources")
+		r.setDegraded(cs, "OrphanCleanupFailed", err.Error())
+		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+			log.Error(patchErr, "Failed to patch status after orphaned resources cleanup error")
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !cs.Spec.ArgocdCluster && !r.DisableArgoCD {
+		if err := r.cleanupArgoCDClusterSecrets(ctx, cs, nil); err != nil {
 			log.Error(err, "Failed to delete ArgoCD cluster secret")
-			r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionTrue, "ArgoCDCleanupFailed", err.Error())
+			r.setDegraded(cs, "ArgoCDCleanupFailed", err.Error())
 			if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
 				log.Error(patchErr, "Failed to patch status after ArgoCD cleanup error")
 			}
@@ -184,11 +631,19 @@ func (r *CertificateSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	// Step 6: Verify all resources are Ready
+	if err := r.syncCertificateExpiry(ctx, cs); err != nil {
+		log.Error(err, "Failed to read certificate expiry")
+		r.setDegraded(cs, "CheckFailed", err.Error())
+		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
+			log.Error(patchErr, "Failed to patch status after certificate expiry error")
+		}
+		return ctrl.Result{}, err
+	}
+
 	allReady, notReadyReason, err := r.checkAllResourcesReady(ctx, cs)
 	if err != nil {
 		log.Error(err, "Failed to check resources readiness")
-		r.setCondition(cs, ConditionTypeReady, metav1.ConditionFalse, "CheckFailed", err.Error())
-		r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionTrue, "Error", err.Error())
+		r.setDegraded(cs, "CheckFailed", err.Error())
 		if patchErr := r.patchStatus(ctx, cs, csOriginal); patchErr != nil {
 			log.Error(patchErr, "Failed to patch status after readiness check error")
 		}
@@ -199,17 +654,20 @@ func (r *CertificateSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		log.Info("Waiting for all resources to become ready", "reason", notReadyReason)
 		r.setCondition(cs, ConditionTypeReady, metav1.ConditionFalse, "WaitingForResources", notReadyReason)
 		r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionTrue, "ResourcesPending", notReadyReason)
+		cs.Status.Phase = incloudiov1alpha1.PhaseWaitingForResources
 		r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionFalse, "Healthy", "No errors")
 		if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{RequeueAfter: defaultRequeueAfter}, nil
+		return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
 	}
 
 	// Step 7: All resources are ready - update status conditions
 	r.setCondition(cs, ConditionTypeReady, metav1.ConditionTrue, "AllResourcesReady", "All certificate resources created and ready")
 	r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionFalse, "Healthy", "No errors")
 	r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionFalse, "Complete", "Reconciliation complete")
+	cs.Status.Phase = incloudiov1alpha1.PhaseReady
+	cs.Status.ObservedGeneration = cs.Generation
 	if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -219,18 +677,112 @@ func (r *CertificateSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	return ctrl.Result{}, nil
 }
 
-// reconcileDelete handles deletion of cross-namespace resources
+// reconcileDelete handles deletion of cross-namespace and cluster-scoped resources
 func (r *CertificateSetReconciler) reconcileDelete(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 	log.Info("Handling CertificateSet deletion", "name", cs.Name)
 
-	argocdSecretName := ArgoCDClusterName(cs)
-	if err := r.deleteSecretIfExists(ctx, ArgoCDNamespace, argocdSecretName); err != nil {
-		log.Error(err, "Failed to delete ArgoCD cluster secret", "name", argocdSecretName)
+	csOriginal := cs.DeepCopy()
+	cs.Status.Phase = incloudiov1alpha1.PhaseDeleting
+	r.setCondition(cs, ConditionTypeReady, metav1.ConditionFalse, "Deleting", "CertificateSet is being deleted")
+	r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionTrue, "Deleting", "Cleaning up managed resources")
+	if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Orphaned Secrets lose their owner labels first, so cleanupTargetNamespace below leaves them in place
+	if cs.Spec.OrphanSecretsOnDelete {
+		if err := r.orphanGeneratedSecrets(ctx, cs); err != nil {
+			log.Error(err, "Failed to orphan generated secrets")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !r.DisableArgoCD && !cs.Spec.OrphanSecretsOnDelete {
+		argocdSecrets := knownArgoCDClusterSecrets(cs)
+		if err := r.cleanupArgoCDClusterSecrets(ctx, cs, nil); err != nil {
+			log.Error(err, "Failed to delete ArgoCD cluster secrets")
+			return ctrl.Result{}, err
+		}
+
+		// Nothing removes the cross-namespace Secrets once the finalizer is gone, so confirm the deletion
+		// took effect; a Secret that is still terminating or was recreated meanwhile is retried
+		remaining, err := r.remainingSecrets(ctx, argocdSecrets)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if len(remaining) > 0 {
+			log.Info("Waiting for ArgoCD cluster Secrets to be deleted", "secrets", remaining)
+			names := make([]string, 0, len(remaining))
+			for _, key := range remaining {
+				names = append(names, key.String())
+				// Keep them in status so the next attempt finds them even after a spec change
+				r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeArgoCDCluster, key.Namespace, key.Name)
+			}
+			msg := fmt.Sprintf("Waiting for ArgoCD cluster Secrets to be deleted: %s", strings.Join(names, ", "))
+			r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionTrue, "Deleting", msg)
+			if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: r.requeueInterval()}, nil
+		}
+	}
+
+	if !cs.Spec.OrphanSecretsOnDelete {
+		if err := r.cleanupKubeconfigMirrorSecrets(ctx, cs, nil); err != nil {
+			log.Error(err, "Failed to delete kubeconfig mirror secrets")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if usesClusterIssuer(cs) {
+		if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Name: ClusterIssuerName(cs)}, &certmanagerv1.ClusterIssuer{}); err != nil {
+			log.Error(err, "Failed to delete ClusterIssuer", "name", ClusterIssuerName(cs))
+			return ctrl.Result{}, err
+		}
+	}
+
+	if err := r.cleanupTargetNamespace(ctx, cs); err != nil {
+		log.Error(err, "Failed to delete resources in target namespace", "namespace", TargetNamespace(cs))
+		return ctrl.Result{}, err
+	}
+
+	if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Namespace: TargetNamespace(cs), Name: ClusterInfoName(cs)}, &corev1.ConfigMap{}); err != nil {
+		log.Error(err, "Failed to delete cluster-info ConfigMap", "name", ClusterInfoName(cs))
+		return ctrl.Result{}, err
+	}
+
+	if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Namespace: TargetNamespace(cs), Name: CACertConfigMapName(cs)}, &corev1.ConfigMap{}); err != nil {
+		log.Error(err, "Failed to delete CA certificate ConfigMap", "name", CACertConfigMapName(cs))
 		return ctrl.Result{}, err
 	}
 
-	controllerutil.RemoveFinalizer(cs, finalizerName)
+	if cs.Spec.OIDCCABundleConfigMap != "" {
+		if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Namespace: TargetNamespace(cs), Name: cs.Spec.OIDCCABundleConfigMap}, &corev1.ConfigMap{}); err != nil {
+			log.Error(err, "Failed to delete OIDC CA bundle ConfigMap", "name", cs.Spec.OIDCCABundleConfigMap)
+			return ctrl.Result{}, err
+		}
+	}
+
+	// cert-manager leaves Secrets behind when a Certificate is deleted; the PKCS#12
+	// keystore is a self-contained credential bundle, so it is removed with the CertificateSet
+	if cs.Spec.Pkcs12 && !cs.Spec.OrphanSecretsOnDelete {
+		if err := r.deleteCertificateSecretIfExists(ctx, TargetNamespace(cs), SuperAdminName(cs)); err != nil {
+			log.Error(err, "Failed to delete super-admin Secret with PKCS#12 keystore", "name", SuperAdminName(cs))
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !cs.Spec.OrphanSecretsOnDelete {
+		if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Namespace: TargetNamespace(cs), Name: CAJKSName(cs)}, &corev1.Secret{}); err != nil {
+			log.Error(err, "Failed to delete JKS truststore Secret", "name", CAJKSName(cs))
+			return ctrl.Result{}, err
+		}
+	}
+
+	r.secretWaitBackoff.forget(client.ObjectKeyFromObject(cs))
+	forgetStatusMetrics(client.ObjectKeyFromObject(cs))
+	controllerutil.RemoveFinalizer(cs, r.finalizer())
 	if err := r.Update(ctx, cs); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -239,13 +791,224 @@ func (r *CertificateSetReconciler) reconcileDelete(ctx context.Context, cs *incl
 	return ctrl.Result{}, nil
 }
 
+// finalizer returns the finalizer name used by this controller
+func (r *CertificateSetReconciler) finalizer() string {
+	if r.FinalizerName != "" {
+		return r.FinalizerName
+	}
+	return DefaultFinalizerName
+}
+
+// requeueInterval returns the requeue delay of waiting branches
+func (r *CertificateSetReconciler) requeueInterval() time.Duration {
+	if r.RequeueInterval > 0 {
+		return r.RequeueInterval
+	}
+	return DefaultRequeueInterval
+}
+
+// clusterResourceNamespace returns the namespace where cert-manager reads the Secrets of ClusterIssuers
+func (r *CertificateSetReconciler) clusterResourceNamespace() string {
+	if r.ClusterResourceNamespace != "" {
+		return r.ClusterResourceNamespace
+	}
+	return DefaultClusterResourceNamespace
+}
+
+// skipFinalizer reports whether the CertificateSet opted out of the cleanup finalizer. The opt-out is
+// ignored while resources outside the CertificateSet namespace are managed, since nothing would remove them,
+// and when Secrets must be orphaned, since only the finalizer detaches them before garbage collection.
+func skipFinalizer(cs *incloudiov1alpha1.CertificateSet) bool {
+	if cs.Annotations[SkipFinalizerAnnotation] != "true" || cs.Spec.OrphanSecretsOnDelete {
+		return false
+	}
+	return !cs.Spec.ArgocdCluster && !usesClusterIssuer(cs) && TargetNamespace(cs) == cs.Namespace &&
+		len(KubeconfigMirrorSecrets(cs)) == 0
+}
+
+// reconcileDryRun writes the resources the spec would produce into status without creating them
+func (r *CertificateSetReconciler) reconcileDryRun(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	csOriginal := cs.DeepCopy()
+	cs.Status.PlannedResources = PlannedResources(cs)
+
+	msg := fmt.Sprintf("Dry-run: %d resources planned, nothing created", len(cs.Status.PlannedResources))
+	r.setCondition(cs, ConditionTypeReady, metav1.ConditionFalse, "DryRun", msg)
+	r.setCondition(cs, ConditionTypeProgressing, metav1.ConditionFalse, "DryRun", msg)
+	if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("CertificateSet dry-run complete", "name", cs.Name, "planned", len(cs.Status.PlannedResources))
+	return ctrl.Result{}, nil
+}
+
+// reconcilePaused only reports the pause in status. Nothing is requeued: removing the annotation is an
+// update of the CertificateSet and triggers a normal reconciliation.
+func (r *CertificateSetReconciler) reconcilePaused(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	r.secretWaitBackoff.forget(client.ObjectKeyFromObject(cs))
+
+	csOriginal := cs.DeepCopy()
+	msg := fmt.Sprintf("Reconciliation is paused by the %s annotation", PausedAnnotation)
+	if r.setCondition(cs, ConditionTypePaused, metav1.ConditionTrue, "Paused", msg) {
+		r.Recorder.Event(cs, corev1.EventTypeNormal, "Paused", msg)
+	}
+	if err := r.patchStatus(ctx, cs, csOriginal); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("CertificateSet reconciliation is paused", "name", cs.Name)
+	return ctrl.Result{}, nil
+}
+
 // SetupWithManager sets up the controller with the Manager.
+//
+// cert-manager updates the Certificate status after writing a renewed Secret, so watching
+// owned Certificates also re-triggers reconciliation when the super-admin key rotates and
+// the derived secrets get re-rendered from the new tls.crt.
+//
+// Secrets written by cert-manager are not owned by the CertificateSet, so they are watched
+// separately and mapped back through the issuing Certificate once they contain certificate data.
+// Resources in spec.targetNamespace carry owner labels instead of OwnerReferences and are mapped by them.
 func (r *CertificateSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("certificateset-controller")
+	}
+
+	// Status patches of the CertificateSet itself do not re-enter; annotations (rotate-ca, paused, dry-run)
+	// and labels (copied to child resources) are not part of the generation, so they are watched separately
+	primary := builder.WithPredicates(predicate.Or(
+		predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}, predicate.LabelChangedPredicate{}))
+	children := builder.WithPredicates(childChangedPredicate())
+
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&incloudiov1alpha1.CertificateSet{}).
-		Owns(&corev1.Secret{}).
-		Owns(&certmanagerv1.Certificate{}).
-		Owns(&certmanagerv1.Issuer{}).
-		Named("certificateset").
+		For(&incloudiov1alpha1.CertificateSet{}, primary).
+		Owns(&corev1.Secret{}, children).
+		Owns(&certmanagerv1.Certificate{}, children).
+		Owns(&certmanagerv1.Issuer{}, children).
+		Watches(&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.certificateSecretToCertificateSet),
+			builder.WithPredicates(certificateSecretDataPredicate())).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(ownerLabelsToCertificateSet), children).
+		Watches(&certmanagerv1.Certificate{}, handler.EnqueueRequestsFromMapFunc(ownerLabelsToCertificateSet), children).
+		Watches(&certmanagerv1.Issuer{}, handler.EnqueueRequestsFromMapFunc(ownerLabelsToCertificateSet), children).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}).
 		Complete(r)
 }
+
+// certificateSecretToCertificateSet maps a cert-manager Secret to the CertificateSet owning
+// the Certificate named in its cert-manager.io/certificate-name annotation
+func (r *CertificateSetReconciler) certificateSecretToCertificateSet(ctx context.Context, obj client.Object) []reconcile.Request {
+	certName := obj.GetAnnotations()[certmanagerv1.CertificateNameKey]
+	if certName == "" {
+		return nil
+	}
+
+	cert := &certmanagerv1.Certificate{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: certName}, cert); err != nil {
+		return nil
+	}
+
+	if requests := ownerLabelsToCertificateSet(ctx, cert); len(requests) > 0 {
+		return requests
+	}
+
+	owner := metav1.GetControllerOf(cert)
+	if owner == nil || owner.Kind != "CertificateSet" || owner.APIVersion != incloudiov1alpha1.GroupVersion.String() {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: cert.Namespace, Name: owner.Name}}}
+}
+
+// ownerLabelsToCertificateSet maps a resource in a target namespace to the CertificateSet named in its owner labels
+func ownerLabelsToCertificateSet(_ context.Context, obj client.Object) []reconcile.Request {
+	name, namespace := obj.GetLabels()[OwnerNameLabel], obj.GetLabels()[OwnerNamespaceLabel]
+	if name == "" || namespace == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}}
+}
+
+// childChangedPredicate drops child updates that cannot change the reconcile outcome, such as cert-manager
+// status bookkeeping. Updates pass when the spec changes (generation), a Certificate or Issuer Ready condition
+// flips, a Certificate is renewed (notAfter), or Secret data, type, labels or annotations change, so that
+// edits to managed Secret metadata are reverted. Creates and deletes always pass.
+func childChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() {
+				return true
+			}
+			switch newObj := e.ObjectNew.(type) {
+			case *certmanagerv1.Certificate:
+				oldObj, ok := e.ObjectOld.(*certmanagerv1.Certificate)
+				return !ok || certificateReadyStatus(oldObj) != certificateReadyStatus(newObj) ||
+					!oldObj.Status.NotAfter.Equal(newObj.Status.NotAfter)
+			case *certmanagerv1.Issuer:
+				oldObj, ok := e.ObjectOld.(*certmanagerv1.Issuer)
+				return !ok || issuerReadyStatus(oldObj) != issuerReadyStatus(newObj)
+			case *corev1.Secret:
+				oldObj, ok := e.ObjectOld.(*corev1.Secret)
+				return !ok || oldObj.Type != newObj.Type || !reflect.DeepEqual(oldObj.Data, newObj.Data) ||
+					!maps.Equal(oldObj.Labels, newObj.Labels) || !maps.Equal(oldObj.Annotations, newObj.Annotations)
+			}
+			return true
+		},
+	}
+}
+
+// certificateReadyStatus returns the status of the Ready condition of a Certificate, empty if it has none
+func certificateReadyStatus(cert *certmanagerv1.Certificate) cmmeta.ConditionStatus {
+	for _, cond := range cert.Status.Conditions {
+		if cond.Type == certmanagerv1.CertificateConditionReady {
+			return cond.Status
+		}
+	}
+	return ""
+}
+
+// issuerReadyStatus returns the status of the Ready condition of an Issuer, empty if it has none
+func issuerReadyStatus(issuer *certmanagerv1.Issuer) cmmeta.ConditionStatus {
+	for _, cond := range issuer.Status.Conditions {
+		if cond.Type == certmanagerv1.IssuerConditionReady {
+			return cond.Status
+		}
+	}
+	return ""
+}
+
+// certificateSecretDataPredicate passes Secret events only when the Secret gains ca.crt, tls.crt and tls.key,
+// or when ca.crt or tls.crt of an issued Secret changes. A new ca.crt alone (e.g. after CA rotation) has to
+// re-render the kubeconfig and ArgoCD Secrets, which embed it as certificate-authority-data.
+func certificateSecretDataPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			secret, ok := e.Object.(*corev1.Secret)
+			return ok && hasCertificateData(secret)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldSecret, okOld := e.ObjectOld.(*corev1.Secret)
+			newSecret, okNew := e.ObjectNew.(*corev1.Secret)
+			if !okOld || !okNew || !hasCertificateData(newSecret) {
+				return false
+			}
+			return !hasCertificateData(oldSecret) ||
+				!bytes.Equal(oldSecret.Data["ca.crt"], newSecret.Data["ca.crt"]) ||
+				!bytes.Equal(oldSecret.Data["tls.crt"], newSecret.Data["tls.crt"])
+		},
+		DeleteFunc: func(event.DeleteEvent) bool {
+			return false
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
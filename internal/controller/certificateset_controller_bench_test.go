@@ -0,0 +1,128 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"golang.org/x/sync/errgroup"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+// benchAPILatency simulates the round trip to the API server for every client call
+const benchAPILatency = time.Millisecond
+
+// BenchmarkReconcileConcurrency reconciles a batch of new CertificateSets with 1, 4 and 16 workers,
+// like MaxConcurrentReconciles does. Reconciliation is bound by API latency, so throughput grows with workers.
+func BenchmarkReconcileConcurrency(b *testing.B) {
+	const batch = 64
+
+	for _, workers := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for b.Loop() {
+				b.StopTimer()
+				r, requests := newBenchReconciler(b, batch)
+				b.StartTimer()
+
+				var g errgroup.Group
+				g.SetLimit(workers)
+				for _, req := range requests {
+					g.Go(func() error {
+						_, err := r.Reconcile(context.Background(), req)
+						return err
+					})
+				}
+				if err := g.Wait(); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(batch*b.N)/b.Elapsed().Seconds(), "reconciles/s")
+		})
+	}
+}
+
+// newBenchReconciler returns a reconciler over a fake client holding n CertificateSets
+func newBenchReconciler(b *testing.B, n int) (*CertificateSetReconciler, []ctrl.Request) {
+	b.Helper()
+
+	objs := []client.Object{
+		&certmanagerv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: "root"}},
+	}
+	requests := make([]ctrl.Request, 0, n)
+	for i := range n {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("bench-%d", i), Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:   incloudiov1alpha1.IssuerReference{APIVersion: "cert-manager.io/v1", Kind: "ClusterIssuer", Name: "root"},
+			},
+		}
+		objs = append(objs, cs)
+		requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cs)})
+	}
+
+	delay := func() { time.Sleep(benchAPILatency) }
+	c := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&incloudiov1alpha1.CertificateSet{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				delay()
+				return c.Get(ctx, key, obj, opts...)
+			},
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				delay()
+				return c.List(ctx, list, opts...)
+			},
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				delay()
+				return c.Create(ctx, obj, opts...)
+			},
+			Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+				delay()
+				return c.Update(ctx, obj, opts...)
+			},
+			Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+				delay()
+				return c.Patch(ctx, obj, patch, opts...)
+			},
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				delay()
+				return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+			},
+		}).
+		Build()
+
+	return &CertificateSetReconciler{
+		Client:    c,
+		APIReader: c,
+		Scheme:    testScheme,
+		Recorder:  &record.FakeRecorder{},
+	}, requests
+}
@@ -17,17 +17,29 @@ limitations under the License.
 package controller
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
 	"encoding/base64"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"text/template"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -46,77 +58,375 @@ func (r *CertificateSetReconciler) isSecretReady(ctx context.Context, namespace,
 		return false, err
 	}
 
+	return hasCertificateData(secret), nil
+}
+
+// hasCertificateData checks if a Secret contains ca.crt, tls.crt and tls.key
+func hasCertificateData(secret *corev1.Secret) bool {
 	_, hasCACrt := secret.Data["ca.crt"]
 	_, hasTLSCrt := secret.Data["tls.crt"]
 	_, hasTLSKey := secret.Data["tls.key"]
 
-	return hasCACrt && hasTLSCrt && hasTLSKey, nil
+	return hasCACrt && hasTLSCrt && hasTLSKey
 }
 
-// isCertificateReady checks if a cert-manager Certificate has Ready=True condition
-func (r *CertificateSetReconciler) isCertificateReady(ctx context.Context, namespace, name string) (bool, error) {
+// getCertificateReadyCondition returns the Ready condition of a cert-manager Certificate
+// as status, reason and message; a missing Certificate or condition is reported as not ready
+func (r *CertificateSetReconciler) getCertificateReadyCondition(ctx context.Context, namespace, name string) (metav1.ConditionStatus, string, string, error) {
 	cert := &certmanagerv1.Certificate{}
 	err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cert)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return false, nil
+			return metav1.ConditionFalse, "NotFound", fmt.Sprintf("Certificate %s not found", name), nil
 		}
-		return false, err
+		return metav1.ConditionUnknown, "", "", err
+	}
+
+	issuingFailure := certificateIssuingFailure(cert)
+	for _, cond := range cert.Status.Conditions {
+		if cond.Type != certmanagerv1.CertificateConditionReady {
+			continue
+		}
+		reason := cond.Reason
+		if reason == "" {
+			reason = "Unknown"
+		}
+		status := metav1.ConditionFalse
+		if cond.Status == cmmeta.ConditionTrue {
+			status = metav1.ConditionTrue
+		}
+		message := cond.Message
+		if status != metav1.ConditionTrue && issuingFailure != "" && issuingFailure != message {
+			message = joinMessages(message, "issuing failed: "+issuingFailure)
+		}
+		return status, reason, message, nil
 	}
+	if issuingFailure != "" {
+		return metav1.ConditionFalse, "Failed", "issuing failed: " + issuingFailure, nil
+	}
+	return metav1.ConditionFalse, "Pending", fmt.Sprintf("Certificate %s has no Ready condition yet", name), nil
+}
 
+// certificateIssuingFailure returns the message of a failed Issuing condition.
+// cert-manager reports issuer and CertificateRequest errors there, while Ready only says the certificate is not up to date
+func certificateIssuingFailure(cert *certmanagerv1.Certificate) string {
 	for _, cond := range cert.Status.Conditions {
-		if cond.Type == certmanagerv1.CertificateConditionReady {
-			return cond.Status == cmmeta.ConditionTrue, nil
+		if cond.Type == certmanagerv1.CertificateConditionIssuing &&
+			cond.Status == cmmeta.ConditionFalse && cond.Reason == "Failed" {
+			return cond.Message
 		}
 	}
-	return false, nil
+	return ""
 }
 
-// isIssuerReady checks if a cert-manager Issuer has Ready=True condition
-func (r *CertificateSetReconciler) isIssuerReady(ctx context.Context, namespace, name string) (bool, error) {
+// joinMessages joins non-empty condition messages with "; "
+func joinMessages(messages ...string) string {
+	nonEmpty := make([]string, 0, len(messages))
+	for _, m := range messages {
+		if m != "" {
+			nonEmpty = append(nonEmpty, m)
+		}
+	}
+	return strings.Join(nonEmpty, "; ")
+}
+
+// issuerReadyCondition reports whether the Ready condition is True and returns its message
+func issuerReadyCondition(conditions []certmanagerv1.IssuerCondition) (bool, string) {
+	for _, cond := range conditions {
+		if cond.Type == certmanagerv1.IssuerConditionReady {
+			return cond.Status == cmmeta.ConditionTrue, cond.Message
+		}
+	}
+	return false, ""
+}
+
+// isIssuerReady checks if a cert-manager Issuer has Ready=True condition.
+// The message of the Ready condition is returned so callers can surface why the Issuer is not ready
+func (r *CertificateSetReconciler) isIssuerReady(ctx context.Context, namespace, name string) (bool, string, error) {
 	issuer := &certmanagerv1.Issuer{}
 	err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, issuer)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return false, nil
+			return false, "not found", nil
 		}
-		return false, err
+		return false, "", err
 	}
 
-	for _, cond := range issuer.Status.Conditions {
-		if cond.Type == certmanagerv1.IssuerConditionReady {
-			return cond.Status == cmmeta.ConditionTrue, nil
+	ready, message := issuerReadyCondition(issuer.Status.Conditions)
+	return ready, message, nil
+}
+
+// isClusterIssuerReady checks if a cert-manager ClusterIssuer has Ready=True condition and returns its message
+func (r *CertificateSetReconciler) isClusterIssuerReady(ctx context.Context, name string) (bool, string, error) {
+	issuer := &certmanagerv1.ClusterIssuer{}
+	err := r.APIReader.Get(ctx, types.NamespacedName{Name: name}, issuer)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, "not found", nil
+		}
+		return false, "", err
+	}
+
+	ready, message := issuerReadyCondition(issuer.Status.Conditions)
+	return ready, message, nil
+}
+
+// checkCertManagerInstalled looks up the cert-manager Certificate kind in the RESTMapper
+func (r *CertificateSetReconciler) checkCertManagerInstalled() error {
+	gk := schema.GroupKind{Group: certmanagerv1.SchemeGroupVersion.Group, Kind: certmanagerv1.CertificateKind}
+	if _, err := r.RESTMapper().RESTMapping(gk, certmanagerv1.SchemeGroupVersion.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			return fmt.Errorf("%w: CRD certificates.cert-manager.io/v1 not found, install cert-manager (https://cert-manager.io/docs/installation/)", ErrCertManagerMissing)
+		}
+		return fmt.Errorf("failed to look up cert-manager Certificate kind: %w", err)
+	}
+	return nil
+}
+
+// setOwner makes cs the controller of obj. OwnerReferences cannot cross namespaces, so objects in
+// a target namespace get owner labels instead and are removed by reconcileDelete.
+func (r *CertificateSetReconciler) setOwner(cs *incloudiov1alpha1.CertificateSet, obj client.Object) error {
+	if obj.GetNamespace() == cs.Namespace {
+		return controllerutil.SetControllerReference(cs, obj, r.Scheme)
+	}
+	labels := maps.Clone(obj.GetLabels())
+	if labels == nil {
+		labels = make(map[string]string, 2)
+	}
+	labels[OwnerNameLabel] = cs.Name
+	labels[OwnerNamespaceLabel] = cs.Namespace
+	obj.SetLabels(labels)
+	return nil
+}
+
+// isOwnedBy reports whether obj is controlled by cs, by OwnerReference or, in a target namespace, by owner labels
+func isOwnedBy(cs *incloudiov1alpha1.CertificateSet, obj client.Object) bool {
+	if obj.GetNamespace() != cs.Namespace {
+		return obj.GetLabels()[OwnerNameLabel] == cs.Name && obj.GetLabels()[OwnerNamespaceLabel] == cs.Namespace
+	}
+	return metav1.IsControlledBy(obj, cs)
+}
+
+// checkAdoptable refuses to modify an existing object that is not controlled by cs. Objects without
+// a controller can be taken over when the CertificateSet carries the adopt annotation; objects
+// controlled by someone else are never taken over.
+func checkAdoptable(cs *incloudiov1alpha1.CertificateSet, obj client.Object) error {
+	if obj.GetResourceVersion() == "" || isOwnedBy(cs, obj) {
+		return nil
+	}
+	if owner := metav1.GetControllerOf(obj); owner != nil {
+		return fmt.Errorf("%w: %s is controlled by %s %s", ErrResourceConflict, obj.GetName(), owner.Kind, owner.Name)
+	}
+	if cs.Annotations[AdoptAnnotation] != "true" {
+		return fmt.Errorf("%w: %s already exists and is not owned by this CertificateSet; set annotation %s=true to adopt it",
+			ErrResourceConflict, obj.GetName(), AdoptAnnotation)
+	}
+	return nil
+}
+
+// checkIssuerRefExists verifies that the cert-manager Issuer or ClusterIssuer referenced by spec.issuerRef exists.
+// The CRD schema restricts the reference to cert-manager.io and to these two kinds.
+func (r *CertificateSetReconciler) checkIssuerRefExists(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	ref := defaultIssuerReference(cs.Spec.IssuerRef)
+
+	var obj client.Object = &certmanagerv1.ClusterIssuer{}
+	key := types.NamespacedName{Name: ref.Name}
+	if ref.Kind == certmanagerv1.IssuerKind {
+		obj, key = &certmanagerv1.Issuer{}, types.NamespacedName{Namespace: TargetNamespace(cs), Name: ref.Name}
+	}
+
+	if err := r.APIReader.Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%w: %s %s", ErrIssuerNotFound, ref.Kind, ref.Name)
+		}
+		return fmt.Errorf("failed to get %s %s: %w", ref.Kind, ref.Name, err)
+	}
+	return nil
+}
+
+// syncCertificateExpiry copies status.notAfter of the CA and super-admin Certificates, and status.renewalTime of
+// the super-admin Certificate, into the CertificateSet status.
+// An existing CA has no Certificate, so its expiry is read from tls.crt of the Secret.
+func (r *CertificateSetReconciler) syncCertificateExpiry(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	getCAExpiry := r.getCertificateNotAfter
+	if usesExistingCA(cs) {
+		getCAExpiry = r.getSecretNotAfter
+	}
+	caExpiry, err := getCAExpiry(ctx, TargetNamespace(cs), CASecretName(cs))
+	if err != nil {
+		return err
+	}
+	cs.Status.CAExpiry = caExpiry
+
+	cs.Status.ClientExpiry = nil
+	cs.Status.NextClientRenewal = nil
+	if needsSuperAdmin(cs) {
+		status, err := r.getCertificateStatus(ctx, TargetNamespace(cs), SuperAdminName(cs))
+		if err != nil {
+			return err
+		}
+		cs.Status.ClientExpiry = status.NotAfter
+		cs.Status.NextClientRenewal = status.RenewalTime
+	}
+	return nil
+}
+
+// getCertificateNotAfter returns status.notAfter of a cert-manager Certificate, or nil if it is not issued yet
+func (r *CertificateSetReconciler) getCertificateNotAfter(ctx context.Context, namespace, name string) (*metav1.Time, error) {
+	status, err := r.getCertificateStatus(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return status.NotAfter, nil
+}
+
+// getCertificateStatus returns the status of a cert-manager Certificate, empty if the Certificate does not exist
+func (r *CertificateSetReconciler) getCertificateStatus(ctx context.Context, namespace, name string) (certmanagerv1.CertificateStatus, error) {
+	cert := &certmanagerv1.Certificate{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cert); err != nil {
+		if apierrors.IsNotFound(err) {
+			return certmanagerv1.CertificateStatus{}, nil
 		}
+		return certmanagerv1.CertificateStatus{}, fmt.Errorf("failed to get Certificate %s: %w", name, err)
+	}
+	return cert.Status, nil
+}
+
+// getSecretNotAfter returns NotAfter of the first certificate in tls.crt of a Secret, or nil if the Secret is missing
+func (r *CertificateSetReconciler) getSecretNotAfter(ctx context.Context, namespace, name string) (*metav1.Time, error) {
+	secret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get Secret %s: %w", name, err)
+	}
+	cert, err := parseCertificatePEM(secret.Data["tls.crt"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse tls.crt of Secret %s: %w", name, err)
+	}
+	notAfter := metav1.NewTime(cert.NotAfter)
+	return &notAfter, nil
+}
+
+// parseCertificatePEM parses the first CERTIFICATE block of PEM data
+func parseCertificatePEM(data []byte) (*x509.Certificate, error) {
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("no PEM certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+// checkExistingCASecret verifies that the Secret from spec.existingCASecretRef holds a CA key pair
+func (r *CertificateSetReconciler) checkExistingCASecret(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	namespace, name := TargetNamespace(cs), CASecretName(cs)
+	secret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("%w: Secret %s/%s not found", ErrSecretRefNotReady, namespace, name)
+		}
+		return fmt.Errorf("failed to get Secret %s/%s: %w", namespace, name, err)
+	}
+	for _, key := range []string{"tls.crt", "tls.key"} {
+		if len(secret.Data[key]) == 0 {
+			return fmt.Errorf("%w: Secret %s/%s has no value for key %q", ErrSecretRefNotReady, namespace, name, key)
+		}
+	}
+	return nil
+}
+
+// notReadyMessage formats the WaitingForResources message for a child resource, including its own status message
+func notReadyMessage(kind, name, message string) string {
+	if message == "" {
+		return fmt.Sprintf("%s %s is not ready", kind, name)
 	}
-	return false, nil
+	return fmt.Sprintf("%s %s is not ready: %s", kind, name, message)
 }
 
 // checkAllResourcesReady verifies that all created resources are in Ready state
 // Returns: (allReady, notReadyReason, error)
 func (r *CertificateSetReconciler) checkAllResourcesReady(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) (bool, string, error) {
-	// 1. Check all Certificate resources
+	// 1. Check all Certificate resources, reflecting each one in its own condition
 	certNames := AllCertificateNames(cs)
+	r.removeStaleCertificateConditions(cs, certNames)
 
+	notReadyReason := ""
 	for _, name := range certNames {
-		ready, err := r.isCertificateReady(ctx, cs.Namespace, name)
+		status, reason, message, err := r.getCertificateReadyCondition(ctx, TargetNamespace(cs), name)
 		if err != nil {
 			return false, fmt.Sprintf("error checking Certificate %s: %v", name, err), err
 		}
-		if !ready {
-			return false, fmt.Sprintf("Certificate %s is not ready", name), nil
+		if status != metav1.ConditionTrue && notReadyReason == "" {
+			notReadyReason = notReadyMessage("Certificate", name, message)
 		}
+		r.setCondition(cs, certificateConditionType(cs, name), status, reason, message)
+	}
+	if notReadyReason != "" {
+		return false, notReadyReason, nil
 	}
 
 	// 2. Check Issuer (only if client certs are needed)
-	needsClientCerts := cs.Spec.Kubeconfig || cs.Spec.ArgocdCluster
-	if needsClientCerts {
-		issuerName := CAName(cs)
-		ready, err := r.isIssuerReady(ctx, cs.Namespace, issuerName)
+	if needsClientCertificates(cs) {
+		if usesClusterIssuer(cs) {
+			issuerName := ClusterIssuerName(cs)
+			ready, message, err := r.isClusterIssuerReady(ctx, issuerName)
+			if err != nil {
+				return false, fmt.Sprintf("error checking ClusterIssuer %s: %v", issuerName, err), err
+			}
+			if !ready {
+				return false, notReadyMessage("ClusterIssuer", issuerName, message), nil
+			}
+		} else {
+			issuerName := CAName(cs)
+			ready, message, err := r.isIssuerReady(ctx, TargetNamespace(cs), issuerName)
+			if err != nil {
+				return false, fmt.Sprintf("error checking Issuer %s: %v", issuerName, err), err
+			}
+			if !ready {
+				return false, notReadyMessage("Issuer", issuerName, message), nil
+			}
+		}
+	}
+
+	// The ETCD Issuer signs the etcd leaf certificates
+	if generateETCDLeafCertificates(cs) {
+		issuerName := ETCDName(cs)
+		ready, message, err := r.isIssuerReady(ctx, TargetNamespace(cs), issuerName)
+		if err != nil {
+			return false, fmt.Sprintf("error checking Issuer %s: %v", issuerName, err), err
+		}
+		if !ready {
+			return false, notReadyMessage("Issuer", issuerName, message), nil
+		}
+	}
+
+	// The Proxy Issuer signs the front-proxy client certificate
+	if generateFrontProxyClientCertificate(cs) {
+		issuerName := ProxyName(cs)
+		ready, message, err := r.isIssuerReady(ctx, TargetNamespace(cs), issuerName)
 		if err != nil {
 			return false, fmt.Sprintf("error checking Issuer %s: %v", issuerName, err), err
 		}
 		if !ready {
-			return false, fmt.Sprintf("Issuer %s is not ready", issuerName), nil
+			return false, notReadyMessage("Issuer", issuerName, message), nil
+		}
+	}
+
+	// 3. Check OIDC CA bundle ConfigMap (only if configured)
+	if cmName := cs.Spec.OIDCCABundleConfigMap; cmName != "" {
+		cm := &corev1.ConfigMap{}
+		err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: TargetNamespace(cs), Name: cmName}, cm)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return false, fmt.Sprintf("error checking ConfigMap %s: %v", cmName, err), err
+		}
+		if err != nil || cm.Data["ca.crt"] == "" {
+			return false, fmt.Sprintf("ConfigMap %s is not ready", cmName), nil
 		}
 	}
 
@@ -137,6 +447,81 @@ func (r *CertificateSetReconciler) getCertificateData(ctx context.Context, names
 	}, nil
 }
 
+// getSecretValue reads a non-empty value from a Secret key, trimming surrounding whitespace
+func (r *CertificateSetReconciler) getSecretValue(ctx context.Context, namespace, name, key string) (string, error) {
+	secret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("%w: Secret %s/%s not found", ErrSecretRefNotReady, namespace, name)
+		}
+		return "", fmt.Errorf("failed to get Secret %s/%s: %w", namespace, name, err)
+	}
+
+	value := strings.TrimSpace(string(secret.Data[key]))
+	if value == "" {
+		return "", fmt.Errorf("%w: Secret %s/%s has no value for key %q", ErrSecretRefNotReady, namespace, name, key)
+	}
+	return value, nil
+}
+
+// loadKubeconfigTemplate parses the template referenced by spec.kubeconfigTemplateRef, nil when it is unset
+func (r *CertificateSetReconciler) loadKubeconfigTemplate(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) (*template.Template, error) {
+	ref := cs.Spec.KubeconfigTemplateRef
+	if ref == nil {
+		return nil, nil
+	}
+
+	namespace := TargetNamespace(cs)
+	cm := &corev1.ConfigMap{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("%w: ConfigMap %s/%s not found", ErrTemplateRefNotReady, namespace, ref.Name)
+		}
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s: %w", namespace, ref.Name, err)
+	}
+
+	text := cm.Data[ref.Key]
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("%w: ConfigMap %s/%s has no value for key %q", ErrTemplateRefNotReady, namespace, ref.Name, ref.Key)
+	}
+
+	// Unknown fields fail at render time instead of silently producing "<no value>"
+	tmpl, err := template.New(ref.Name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: ConfigMap %s/%s key %q: %w", ErrTemplateRender, namespace, ref.Name, ref.Key, err)
+	}
+	return tmpl, nil
+}
+
+// createOrUpdateUncached works like controllerutil.CreateOrUpdate but reads obj from the API server:
+// in --namespace mode the cache only covers the watch namespace, not target namespaces
+func (r *CertificateSetReconciler) createOrUpdateUncached(ctx context.Context, obj client.Object, mutate controllerutil.MutateFn) (controllerutil.OperationResult, error) {
+	if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return controllerutil.OperationResultNone, err
+		}
+		if err := mutate(); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		if err := r.Create(ctx, obj); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		return controllerutil.OperationResultCreated, nil
+	}
+
+	existing := obj.DeepCopyObject()
+	if err := mutate(); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	if equality.Semantic.DeepEqual(existing, obj) {
+		return controllerutil.OperationResultNone, nil
+	}
+	if err := r.Update(ctx, obj); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	return controllerutil.OperationResultUpdated, nil
+}
+
 // createOrUpdateCertificate creates or updates a cert-manager Certificate
 func (r *CertificateSetReconciler) createOrUpdateCertificate(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, desired *certmanagerv1.Certificate) error {
 	log := logf.FromContext(ctx)
@@ -148,9 +533,8 @@ func (r *CertificateSetReconciler) createOrUpdateCertificate(ctx context.Context
 		},
 	}
 
-	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, existing, func() error {
-		// Set OwnerReference
-		if err := controllerutil.SetControllerReference(cs, existing, r.Scheme); err != nil {
+	op, err := r.createOrUpdateUncached(ctx, existing, func() error {
+		if err := checkAdoptable(cs, existing); err != nil {
 			return err
 		}
 
@@ -158,6 +542,11 @@ func (r *CertificateSetReconciler) createOrUpdateCertificate(ctx context.Context
 		existing.Labels = desired.Labels
 		existing.Annotations = desired.Annotations
 
+		// Set OwnerReference (or owner labels in a target namespace)
+		if err := r.setOwner(cs, existing); err != nil {
+			return err
+		}
+
 		// Copy spec
 		existing.Spec = desired.Spec
 
@@ -186,9 +575,8 @@ func (r *CertificateSetReconciler) createOrUpdateIssuer(ctx context.Context, cs
 		},
 	}
 
-	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, existing, func() error {
-		// Set OwnerReference
-		if err := controllerutil.SetControllerReference(cs, existing, r.Scheme); err != nil {
+	op, err := r.createOrUpdateUncached(ctx, existing, func() error {
+		if err := checkAdoptable(cs, existing); err != nil {
 			return err
 		}
 
@@ -196,6 +584,11 @@ func (r *CertificateSetReconciler) createOrUpdateIssuer(ctx context.Context, cs
 		existing.Labels = desired.Labels
 		existing.Annotations = desired.Annotations
 
+		// Set OwnerReference (or owner labels in a target namespace)
+		if err := r.setOwner(cs, existing); err != nil {
+			return err
+		}
+
 		// Copy spec
 		existing.Spec = desired.Spec
 
@@ -213,32 +606,163 @@ func (r *CertificateSetReconciler) createOrUpdateIssuer(ctx context.Context, cs
 	return nil
 }
 
-// createOrUpdateSecret creates or updates a Secret, only updating specified keys
-func (r *CertificateSetReconciler) createOrUpdateSecret(ctx context.Context, secret *corev1.Secret, managedKeys []string) error {
+// createOrUpdateClusterIssuer creates or updates a cert-manager ClusterIssuer.
+// A namespaced CertificateSet cannot own a cluster-scoped resource, so the ClusterIssuer carries
+// owner labels instead of an OwnerReference and is removed in reconcileDelete.
+func (r *CertificateSetReconciler) createOrUpdateClusterIssuer(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, desired *certmanagerv1.ClusterIssuer) error {
+	log := logf.FromContext(ctx)
+
+	existing := &certmanagerv1.ClusterIssuer{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: desired.Name,
+		},
+	}
+
+	op, err := r.createOrUpdateUncached(ctx, existing, func() error {
+		if err := checkAdoptable(cs, existing); err != nil {
+			return err
+		}
+
+		// Copy labels and annotations
+		existing.Labels = desired.Labels
+		existing.Annotations = desired.Annotations
+
+		// Set owner labels, so that cleanup can tell the ClusterIssuer from a foreign one
+		if err := r.setOwner(cs, existing); err != nil {
+			return err
+		}
+
+		// Copy spec
+		existing.Spec = desired.Spec
+
+		return nil
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to create/update ClusterIssuer %s: %w", desired.Name, err)
+	}
+
+	if op != controllerutil.OperationResultNone {
+		log.Info("ClusterIssuer reconciled", "name", desired.Name, "operation", op)
+	}
+
+	return nil
+}
+
+// createOrUpdateSecret creates or updates a Secret, only updating specified keys and the desired
+// labels and annotations. Labels and annotations added by others are kept. With force the Secret is
+// updated even if it already matches (see ResyncAnnotation).
+func (r *CertificateSetReconciler) createOrUpdateSecret(ctx context.Context, secret *corev1.Secret, managedKeys []string, force bool) (controllerutil.OperationResult, error) {
 	log := logf.FromContext(ctx)
 
+	key := types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}
 	existing := &corev1.Secret{}
-	err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}, existing)
+	err := r.APIReader.Get(ctx, key, existing)
 	if apierrors.IsNotFound(err) {
 		log.Info("Creating secret", "name", secret.Name)
-		return r.Create(ctx, secret)
-	} else if err != nil {
-		return err
+		err = r.Create(ctx, secret)
+		if err == nil {
+			return controllerutil.OperationResultCreated, nil
+		}
+		if !apierrors.IsAlreadyExists(err) {
+			return controllerutil.OperationResultNone, err
+		}
+		// Someone else created the Secret between the Get and the Create: update it instead
+		log.V(1).Info("Secret was created concurrently, updating it", "name", secret.Name, "namespace", secret.Namespace)
+		err = r.APIReader.Get(ctx, key, existing)
+	}
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	// The API server rejects type changes, so the Secret has to be deleted to be recreated with the new type.
+	// An empty type is defaulted to Opaque by the API server.
+	existingType := existing.Type
+	if existingType == "" {
+		existingType = corev1.SecretTypeOpaque
+	}
+	if secret.Type != "" && existingType != secret.Type {
+		return controllerutil.OperationResultNone, fmt.Errorf("%w: Secret %s/%s has type %q instead of %q; delete it to have it recreated",
+			ErrSecretTypeImmutable, existing.Namespace, existing.Name, existingType, secret.Type)
 	}
 
-	if !secretDataEqualForKeys(existing.Data, secret.Data, managedKeys) {
-		log.Info("Updating secret (data changed)", "name", secret.Name, "namespace", secret.Namespace)
+	dataChanged := !secretDataEqualForKeys(existing.Data, secret.Data, managedKeys)
+	metadataChanged := !stringMapContains(existing.Labels, secret.Labels) ||
+		!stringMapContains(existing.Annotations, secret.Annotations)
+	if dataChanged || metadataChanged || force {
+		log.Info("Updating secret", "name", secret.Name, "namespace", secret.Namespace,
+			"dataChanged", dataChanged, "metadataChanged", metadataChanged, "force", force)
 		if existing.Data == nil {
 			existing.Data = make(map[string][]byte)
 		}
 		for _, k := range managedKeys {
 			existing.Data[k] = secret.Data[k]
 		}
+		if existing.Labels == nil && len(secret.Labels) > 0 {
+			existing.Labels = make(map[string]string, len(secret.Labels))
+		}
+		maps.Copy(existing.Labels, secret.Labels)
+		if existing.Annotations == nil && len(secret.Annotations) > 0 {
+			existing.Annotations = make(map[string]string, len(secret.Annotations))
+		}
+		maps.Copy(existing.Annotations, secret.Annotations)
 
-		return r.Update(ctx, existing)
+		if err := r.Update(ctx, existing); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+		return controllerutil.OperationResultUpdated, nil
 	}
 
-	return nil
+	return controllerutil.OperationResultNone, nil
+}
+
+// createOrUpdateConfigMap creates or updates a ConfigMap, only updating specified keys
+func (r *CertificateSetReconciler) createOrUpdateConfigMap(ctx context.Context, cm *corev1.ConfigMap, managedKeys []string) error {
+	log := logf.FromContext(ctx)
+
+	existing := &corev1.ConfigMap{}
+	err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}, existing)
+	if apierrors.IsNotFound(err) {
+		log.Info("Creating configmap", "name", cm.Name)
+		return r.Create(ctx, cm)
+	} else if err != nil {
+		return err
+	}
+
+	changed := false
+	for _, k := range managedKeys {
+		if existing.Data[k] != cm.Data[k] {
+			changed = true
+			break
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	log.Info("Updating configmap (data changed)", "name", cm.Name, "namespace", cm.Namespace)
+	if existing.Data == nil {
+		existing.Data = make(map[string]string)
+	}
+	for _, k := range managedKeys {
+		existing.Data[k] = cm.Data[k]
+	}
+	return r.Update(ctx, existing)
+}
+
+// resyncRequested reports whether the resync annotation has a value that was not honored yet
+func resyncRequested(cs *incloudiov1alpha1.CertificateSet) bool {
+	resync := cs.Annotations[ResyncAnnotation]
+	return resync != "" && resync != cs.Status.LastResync
+}
+
+// recordSecretEvent emits a Normal event when a derived Secret was created or updated
+func (r *CertificateSetReconciler) recordSecretEvent(cs *incloudiov1alpha1.CertificateSet, secret *corev1.Secret, op controllerutil.OperationResult) {
+	switch op {
+	case controllerutil.OperationResultCreated:
+		r.Recorder.Eventf(cs, corev1.EventTypeNormal, EventReasonSecretCreated, "Created Secret %s/%s", secret.Namespace, secret.Name)
+	case controllerutil.OperationResultUpdated:
+		r.Recorder.Eventf(cs, corev1.EventTypeNormal, EventReasonSecretUpdated, "Updated Secret %s/%s", secret.Namespace, secret.Name)
+	}
 }
 
 // secretDataEqualForKeys compares Secret data for specific keys
@@ -257,6 +781,16 @@ func secretDataEqualForKeys(existing, new map[string][]byte, keys []string) bool
 	return true
 }
 
+// stringMapContains reports whether every entry of desired is present with the same value in existing
+func stringMapContains(existing, desired map[string]string) bool {
+	for k, v := range desired {
+		if cur, ok := existing[k]; !ok || cur != v {
+			return false
+		}
+	}
+	return true
+}
+
 // deleteSecretIfExists deletes a Secret if it exists
 func (r *CertificateSetReconciler) deleteSecretIfExists(ctx context.Context, namespace, name string) error {
 	log := logf.FromContext(ctx)
@@ -277,6 +811,397 @@ func (r *CertificateSetReconciler) deleteSecretIfExists(ctx context.Context, nam
 	return nil
 }
 
+// deleteOwnedIfExists deletes the object at key if it exists and is owned by cs. An object of the
+// same name that was created by someone else is left alone.
+func (r *CertificateSetReconciler) deleteOwnedIfExists(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, key types.NamespacedName, obj client.Object) error {
+	log := logf.FromContext(ctx)
+
+	err := r.APIReader.Get(ctx, key, obj)
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !isOwnedBy(cs, obj) {
+		log.Info("Skipping deletion of resource not owned by the CertificateSet", "kind", fmt.Sprintf("%T", obj), "name", key.Name, "namespace", key.Namespace)
+		return nil
+	}
+
+	log.Info("Deleting resource", "kind", fmt.Sprintf("%T", obj), "name", key.Name, "namespace", key.Namespace)
+	if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteCertificateSecretIfExists deletes the Secret that cert-manager issued for the Certificate certName.
+// A Secret of the same name whose certificate-name annotation points elsewhere is left alone.
+func (r *CertificateSetReconciler) deleteCertificateSecretIfExists(ctx context.Context, namespace, certName string) error {
+	log := logf.FromContext(ctx)
+
+	secret := &corev1.Secret{}
+	err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: certName}, secret)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if secret.Annotations[certmanagerv1.CertificateNameKey] != certName {
+		log.Info("Skipping deletion of Secret not issued for the Certificate", "name", certName, "namespace", namespace)
+		return nil
+	}
+
+	log.Info("Deleting secret", "name", certName, "namespace", namespace)
+	if err := r.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteCertificateIfExists deletes a Certificate if it exists
+func (r *CertificateSetReconciler) deleteCertificateIfExists(ctx context.Context, namespace, name string) error {
+	log := logf.FromContext(ctx)
+
+	cert := &certmanagerv1.Certificate{}
+	err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cert)
+	if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Info("Deleting certificate", "name", name, "namespace", namespace)
+	if err := r.Delete(ctx, cert); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// cleanupStaleClientCertificates deletes the Certificates, Secrets and kubeconfig Secrets of additional
+// client certificates that are recorded in status but no longer listed in spec.clientCertificates
+func (r *CertificateSetReconciler) cleanupStaleClientCertificates(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	desired := make(map[string]bool, 2*len(cs.Spec.ClientCertificates))
+	for _, client := range cs.Spec.ClientCertificates {
+		desired[ClientCertificateName(cs, client.Name)] = true
+		desired[ClientKubeconfigName(cs, client.Name)] = true
+	}
+
+	for _, gs := range slices.Clone(cs.Status.GeneratedSecrets) {
+		if desired[gs.Name] {
+			continue
+		}
+		key := types.NamespacedName{Namespace: gs.Namespace, Name: gs.Name}
+		switch gs.Purpose {
+		case incloudiov1alpha1.SecretPurposeClientCertificate:
+			if err := r.deleteOwnedIfExists(ctx, cs, key, &certmanagerv1.Certificate{}); err != nil {
+				return fmt.Errorf("failed to delete client Certificate %s: %w", gs.Name, err)
+			}
+			if err := r.deleteCertificateSecretIfExists(ctx, gs.Namespace, gs.Name); err != nil {
+				return fmt.Errorf("failed to delete client Secret %s: %w", gs.Name, err)
+			}
+		case incloudiov1alpha1.SecretPurposeClientKubeconfig:
+			if err := r.deleteOwnedIfExists(ctx, cs, key, &corev1.Secret{}); err != nil {
+				return fmt.Errorf("failed to delete client kubeconfig Secret %s: %w", gs.Name, err)
+			}
+		default:
+			continue
+		}
+		r.removeGeneratedSecret(cs, gs.Namespace, gs.Name)
+	}
+
+	return nil
+}
+
+// cleanupOrphanedResources deletes the Certificates, Secrets and Issuers that a previous spec required
+// but the current one does not (e.g. super-admin after disabling kubeconfig and argocdCluster)
+func (r *CertificateSetReconciler) cleanupOrphanedResources(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	desired := make(map[string]bool)
+	for _, name := range AllCertificateNames(cs) {
+		desired[name] = true
+	}
+
+	for _, name := range []string{SuperAdminName(cs), ETCDName(cs), ETCDServerName(cs), ETCDPeerName(cs), ProxyName(cs), FrontProxyClientName(cs), CAOIDCName(cs)} {
+		if desired[name] {
+			continue
+		}
+		cert := &certmanagerv1.Certificate{}
+		err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: TargetNamespace(cs), Name: name}, cert)
+		switch {
+		case apierrors.IsNotFound(err) || meta.IsNoMatchError(err):
+			// Without the Certificate only a Secret recorded in status is known to be issued for this CertificateSet
+			if !slices.ContainsFunc(cs.Status.GeneratedSecrets, func(s incloudiov1alpha1.GeneratedSecret) bool {
+				return s.Namespace == TargetNamespace(cs) && s.Name == name
+			}) {
+				continue
+			}
+		case err != nil:
+			return fmt.Errorf("failed to get Certificate %s: %w", name, err)
+		case !isOwnedBy(cs, cert):
+			// Leave Certificates that were never adopted alone
+			continue
+		default:
+			if err := r.Delete(ctx, cert); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete Certificate %s: %w", name, err)
+			}
+		}
+		if err := r.deleteCertificateSecretIfExists(ctx, TargetNamespace(cs), name); err != nil {
+			return fmt.Errorf("failed to delete Secret %s: %w", name, err)
+		}
+		r.removeGeneratedSecret(cs, TargetNamespace(cs), name)
+	}
+
+	key := func(name string) types.NamespacedName {
+		return types.NamespacedName{Namespace: TargetNamespace(cs), Name: name}
+	}
+
+	if !cs.Spec.Kubeconfig {
+		if err := r.deleteOwnedIfExists(ctx, cs, key(KubeconfigName(cs)), &corev1.Secret{}); err != nil {
+			return fmt.Errorf("failed to delete kubeconfig Secret: %w", err)
+		}
+		r.removeGeneratedSecret(cs, TargetNamespace(cs), KubeconfigName(cs))
+		if err := r.cleanupKubeconfigMirrorSecrets(ctx, cs, nil); err != nil {
+			return fmt.Errorf("failed to delete kubeconfig mirror Secrets: %w", err)
+		}
+	}
+
+	if !cs.Spec.FullChainSecret {
+		if err := r.deleteOwnedIfExists(ctx, cs, key(FullChainName(cs)), &corev1.Secret{}); err != nil {
+			return fmt.Errorf("failed to delete full chain Secret: %w", err)
+		}
+		r.removeGeneratedSecret(cs, TargetNamespace(cs), FullChainName(cs))
+	}
+
+	if !generateClusterInfo(cs) {
+		if err := r.deleteOwnedIfExists(ctx, cs, key(ClusterInfoName(cs)), &corev1.ConfigMap{}); err != nil {
+			return fmt.Errorf("failed to delete cluster-info ConfigMap: %w", err)
+		}
+	}
+
+	if !cs.Spec.PublishCAConfigMap {
+		if err := r.deleteOwnedIfExists(ctx, cs, key(CACertConfigMapName(cs)), &corev1.ConfigMap{}); err != nil {
+			return fmt.Errorf("failed to delete CA certificate ConfigMap: %w", err)
+		}
+	}
+
+	if !generateETCDLeafCertificates(cs) {
+		if err := r.deleteOwnedIfExists(ctx, cs, key(ETCDName(cs)), &certmanagerv1.Issuer{}); err != nil {
+			return fmt.Errorf("failed to delete ETCD Issuer: %w", err)
+		}
+	}
+
+	if !generateFrontProxyClientCertificate(cs) {
+		if err := r.deleteOwnedIfExists(ctx, cs, key(ProxyName(cs)), &certmanagerv1.Issuer{}); err != nil {
+			return fmt.Errorf("failed to delete Proxy Issuer: %w", err)
+		}
+	}
+
+	// Only the issuer kind in use is kept; both are removed when no client certificate is issued
+	if !needsClientCertificates(cs) || usesClusterIssuer(cs) {
+		if err := r.deleteOwnedIfExists(ctx, cs, key(CAName(cs)), &certmanagerv1.Issuer{}); err != nil {
+			return fmt.Errorf("failed to delete Issuer: %w", err)
+		}
+	}
+	if !needsClientCertificates(cs) || !usesClusterIssuer(cs) {
+		if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Name: ClusterIssuerName(cs)}, &certmanagerv1.ClusterIssuer{}); err != nil {
+			return fmt.Errorf("failed to delete ClusterIssuer: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// cleanupTargetNamespace deletes the Certificates, Issuers, Secrets and ConfigMaps that carry the owner
+// labels of cs in spec.targetNamespace. In the CertificateSet namespace OwnerReferences take care of this.
+func (r *CertificateSetReconciler) cleanupTargetNamespace(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	if TargetNamespace(cs) == cs.Namespace {
+		return nil
+	}
+
+	log := logf.FromContext(ctx)
+	selector := client.MatchingLabels{OwnerNameLabel: cs.Name, OwnerNamespaceLabel: cs.Namespace}
+	lists := []client.ObjectList{
+		&certmanagerv1.CertificateList{},
+		&certmanagerv1.IssuerList{},
+		&corev1.SecretList{},
+		&corev1.ConfigMapList{},
+	}
+	for _, list := range lists {
+		if err := r.APIReader.List(ctx, list, client.InNamespace(TargetNamespace(cs)), selector); err != nil {
+			if meta.IsNoMatchError(err) {
+				continue
+			}
+			return fmt.Errorf("failed to list %T in namespace %s: %w", list, TargetNamespace(cs), err)
+		}
+		if err := meta.EachListItem(list, func(item runtime.Object) error {
+			obj := item.(client.Object)
+			log.Info("Deleting resource in target namespace", "kind", fmt.Sprintf("%T", item), "name", obj.GetName(), "namespace", obj.GetNamespace())
+			if err := r.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete %s/%s: %w", obj.GetNamespace(), obj.GetName(), err)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// orphanGeneratedSecrets detaches the Secrets recorded in status from cs, so that neither the garbage collector
+// nor cleanupTargetNamespace removes them. cert-manager Secrets may be owned by their Certificate, which is
+// removed with cs, so Certificate owner references are dropped as well. ArgoCD cluster Secrets have no owner
+// references and are skipped.
+func (r *CertificateSetReconciler) orphanGeneratedSecrets(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	log := logf.FromContext(ctx)
+	for _, s := range cs.Status.GeneratedSecrets {
+		if s.Purpose == incloudiov1alpha1.SecretPurposeArgoCDCluster {
+			continue
+		}
+
+		secret := &corev1.Secret{}
+		if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: s.Namespace, Name: s.Name}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get Secret %s/%s: %w", s.Namespace, s.Name, err)
+		}
+
+		original := secret.DeepCopy()
+		labelled := isOwnedBy(cs, secret) && secret.Namespace != cs.Namespace
+		if labelled {
+			delete(secret.Labels, OwnerNameLabel)
+			delete(secret.Labels, OwnerNamespaceLabel)
+		}
+		secret.OwnerReferences = slices.DeleteFunc(secret.OwnerReferences, func(ref metav1.OwnerReference) bool {
+			return ref.UID == cs.UID || (ref.Kind == certmanagerv1.CertificateKind && strings.HasPrefix(ref.APIVersion, certmanagerv1.SchemeGroupVersion.Group+"/"))
+		})
+		if !labelled && len(secret.OwnerReferences) == len(original.OwnerReferences) {
+			continue
+		}
+
+		log.Info("Orphaning Secret", "name", secret.Name, "namespace", secret.Namespace)
+		if err := r.Patch(ctx, secret, client.MergeFrom(original)); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to orphan Secret %s/%s: %w", s.Namespace, s.Name, err)
+		}
+	}
+	return nil
+}
+
+// cleanupArgoCDClusterSecrets deletes the ArgoCD cluster Secrets recorded in status and those of the
+// currently configured targets, except for the Secrets in keep (nil deletes all of them)
+func (r *CertificateSetReconciler) cleanupArgoCDClusterSecrets(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, keep []types.NamespacedName) error {
+	for _, t := range knownArgoCDClusterSecrets(cs) {
+		if slices.Contains(keep, t) {
+			continue
+		}
+		if err := r.deleteSecretIfExists(ctx, t.Namespace, t.Name); err != nil {
+			return err
+		}
+		r.removeGeneratedSecret(cs, t.Namespace, t.Name)
+	}
+	return nil
+}
+
+// cleanupKubeconfigMirrorSecrets deletes the kubeconfig mirror Secrets recorded in status, except for
+// the Secrets in keep (nil deletes all of them)
+func (r *CertificateSetReconciler) cleanupKubeconfigMirrorSecrets(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, keep []types.NamespacedName) error {
+	for _, s := range slices.Clone(cs.Status.GeneratedSecrets) {
+		key := types.NamespacedName{Namespace: s.Namespace, Name: s.Name}
+		if s.Purpose != incloudiov1alpha1.SecretPurposeKubeconfigMirror || slices.Contains(keep, key) {
+			continue
+		}
+		if err := r.deleteOwnedIfExists(ctx, cs, key, &corev1.Secret{}); err != nil {
+			return err
+		}
+		r.removeGeneratedSecret(cs, key.Namespace, key.Name)
+	}
+	return nil
+}
+
+// checkArgoCDServerUnique refuses to create an ArgoCD cluster Secret when another cluster Secret in the
+// same namespace, not owned by cs, already registers the same server: ArgoCD treats them as conflicting
+// clusters. A Secret that already exists is left to the usual ownership checks, so updates are not blocked.
+// ArgoCD cluster Secrets carry no owner labels, so Secrets recorded in status count as owned: a Secret
+// left behind by a renamed target is removed by cleanupArgoCDClusterSecrets once the new one exists.
+func (r *CertificateSetReconciler) checkArgoCDServerUnique(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, secret *corev1.Secret) error {
+	err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(secret), &corev1.Secret{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to check ArgoCD cluster Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	existing := &corev1.SecretList{}
+	if err := r.APIReader.List(ctx, existing, client.InNamespace(secret.Namespace),
+		client.MatchingLabels{argoCDSecretTypeLabel(cs): "cluster"}); err != nil {
+		return fmt.Errorf("failed to list ArgoCD cluster Secrets in %q: %w", secret.Namespace, err)
+	}
+	known := knownArgoCDClusterSecrets(cs)
+	for i := range existing.Items {
+		s := &existing.Items[i]
+		if isOwnedBy(cs, s) || slices.Contains(known, client.ObjectKeyFromObject(s)) ||
+			!bytes.Equal(s.Data["server"], secret.Data["server"]) {
+			continue
+		}
+		return fmt.Errorf("%w: Secret %s/%s already registers server %q", ErrDuplicateArgoCDServer, s.Namespace, s.Name, s.Data["server"])
+	}
+	return nil
+}
+
+// knownArgoCDClusterSecrets returns the ArgoCD cluster Secrets of the current spec and those recorded in status,
+// which include Secrets of previously configured namespaces or targets
+func knownArgoCDClusterSecrets(cs *incloudiov1alpha1.CertificateSet) []types.NamespacedName {
+	targets := ArgoCDClusterSecrets(cs)
+	for _, s := range cs.Status.GeneratedSecrets {
+		if s.Purpose == incloudiov1alpha1.SecretPurposeArgoCDCluster {
+			targets = append(targets, types.NamespacedName{Namespace: s.Namespace, Name: s.Name})
+		}
+	}
+	return targets
+}
+
+// remainingSecrets returns the Secrets from keys that still exist, read from the API server
+// so a just-deleted Secret is not reported from a stale cache
+func (r *CertificateSetReconciler) remainingSecrets(ctx context.Context, keys []types.NamespacedName) ([]types.NamespacedName, error) {
+	var remaining []types.NamespacedName
+	for _, key := range keys {
+		err := r.APIReader.Get(ctx, key, &corev1.Secret{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to check Secret %s: %w", key, err)
+		}
+		if !slices.Contains(remaining, key) {
+			remaining = append(remaining, key)
+		}
+	}
+	return remaining, nil
+}
+
+// cleanupCABundleSecret deletes the CA bundle Secret and removes it from status
+func (r *CertificateSetReconciler) cleanupCABundleSecret(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Namespace: TargetNamespace(cs), Name: CABundleName(cs)}, &corev1.Secret{}); err != nil {
+		return err
+	}
+	r.removeGeneratedSecret(cs, TargetNamespace(cs), CABundleName(cs))
+	return nil
+}
+
+// cleanupCAJKSSecret deletes the JKS truststore Secret and removes it from status
+func (r *CertificateSetReconciler) cleanupCAJKSSecret(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Namespace: TargetNamespace(cs), Name: CAJKSName(cs)}, &corev1.Secret{}); err != nil {
+		return err
+	}
+	r.removeGeneratedSecret(cs, TargetNamespace(cs), CAJKSName(cs))
+	return nil
+}
+
 // setCondition sets a condition on the CertificateSet, returning true if changed
 func (r *CertificateSetReconciler) setCondition(cs *incloudiov1alpha1.CertificateSet, condType string, status metav1.ConditionStatus, reason, message string) bool {
 	existing := meta.FindStatusCondition(cs.Status.Conditions, condType)
@@ -299,7 +1224,111 @@ func (r *CertificateSetReconciler) setCondition(cs *incloudiov1alpha1.Certificat
 	return true
 }
 
-// patchStatus patches only the status subresource using MergeFrom strategy
+// setDegraded marks the CertificateSet Degraded and flips Ready to False with the same reason,
+// so a Ready=True left over from an earlier successful reconciliation never outlives an error
+func (r *CertificateSetReconciler) setDegraded(cs *incloudiov1alpha1.CertificateSet, reason, message string) {
+	r.setCondition(cs, ConditionTypeReady, metav1.ConditionFalse, reason, message)
+	r.setCondition(cs, ConditionTypeDegraded, metav1.ConditionTrue, reason, message)
+	cs.Status.Phase = incloudiov1alpha1.PhaseDegraded
+}
+
+// certificateConditionType returns the per-certificate condition type for a Certificate name,
+// e.g. CACertificateReady or ClientCertificateReady-deployer
+func certificateConditionType(cs *incloudiov1alpha1.CertificateSet, name string) string {
+	switch name {
+	case CAName(cs):
+		return "CACertificateReady"
+	case SuperAdminName(cs):
+		return "SuperAdminCertificateReady"
+	case ETCDName(cs):
+		return "ETCDCertificateReady"
+	case ETCDServerName(cs):
+		return "ETCDServerCertificateReady"
+	case ETCDPeerName(cs):
+		return "ETCDPeerCertificateReady"
+	case ProxyName(cs):
+		return "ProxyCertificateReady"
+	case FrontProxyClientName(cs):
+		return "FrontProxyClientCertificateReady"
+	case CAOIDCName(cs):
+		return "OIDCCertificateReady"
+	}
+	return clientCertificateConditionPrefix + strings.TrimPrefix(name, cs.Name+"-")
+}
+
+// removeStaleCertificateConditions drops per-certificate conditions of Certificates no longer in certNames
+func (r *CertificateSetReconciler) removeStaleCertificateConditions(cs *incloudiov1alpha1.CertificateSet, certNames []string) {
+	desired := make(map[string]bool, len(certNames))
+	for _, name := range certNames {
+		desired[certificateConditionType(cs, name)] = true
+	}
+
+	for _, cond := range slices.Clone(cs.Status.Conditions) {
+		isCertCondition := strings.HasSuffix(cond.Type, "CertificateReady") ||
+			strings.HasPrefix(cond.Type, clientCertificateConditionPrefix)
+		if isCertCondition && !desired[cond.Type] {
+			meta.RemoveStatusCondition(&cs.Status.Conditions, cond.Type)
+		}
+	}
+}
+
+// setGeneratedSecret records a Secret in status.generatedSecrets
+func (r *CertificateSetReconciler) setGeneratedSecret(cs *incloudiov1alpha1.CertificateSet, purpose incloudiov1alpha1.SecretPurpose, namespace, name string) {
+	for i := range cs.Status.GeneratedSecrets {
+		existing := &cs.Status.GeneratedSecrets[i]
+		if existing.Namespace == namespace && existing.Name == name {
+			existing.Purpose = purpose
+			return
+		}
+	}
+
+	cs.Status.GeneratedSecrets = append(cs.Status.GeneratedSecrets, incloudiov1alpha1.GeneratedSecret{
+		Name:      name,
+		Namespace: namespace,
+		Purpose:   purpose,
+	})
+}
+
+// removeGeneratedSecret removes a Secret from status.generatedSecrets
+func (r *CertificateSetReconciler) removeGeneratedSecret(cs *incloudiov1alpha1.CertificateSet, namespace, name string) {
+	cs.Status.GeneratedSecrets = slices.DeleteFunc(cs.Status.GeneratedSecrets, func(s incloudiov1alpha1.GeneratedSecret) bool {
+		return s.Namespace == namespace && s.Name == name
+	})
+}
+
+// patchStatus patches only the status subresource using MergeFrom strategy. The patch carries the
+// resourceVersion of original, so a concurrent write fails with a conflict; the computed status then
+// replaces the status of the latest CertificateSet and is patched again.
 func (r *CertificateSetReconciler) patchStatus(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, original *incloudiov1alpha1.CertificateSet) error {
-	return r.Status().Patch(ctx, cs, client.MergeFrom(original))
+	err := r.Status().Patch(ctx, cs, client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}))
+	if apierrors.IsConflict(err) {
+		logf.FromContext(ctx).V(1).Info("Status patch conflict, retrying on latest CertificateSet")
+		err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+			return r.repatchStatus(ctx, cs)
+		})
+	}
+	if err != nil {
+		return err
+	}
+	recordStatusMetrics(cs, original)
+	return nil
+}
+
+// repatchStatus re-fetches the CertificateSet, replaces its status with the one computed in cs and
+// patches it. The whole status is replaced, so conditions removed by this reconcile stay removed.
+func (r *CertificateSetReconciler) repatchStatus(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	latest := &incloudiov1alpha1.CertificateSet{}
+	if err := r.APIReader.Get(ctx, client.ObjectKeyFromObject(cs), latest); err != nil {
+		return err
+	}
+	latestOriginal := latest.DeepCopy()
+
+	latest.Status = *cs.Status.DeepCopy()
+	if err := r.Status().Patch(ctx, latest, client.MergeFromWithOptions(latestOriginal, client.MergeFromWithOptimisticLock{})); err != nil {
+		return err
+	}
+	// Later writes of cs, such as removing the finalizer, must not conflict on the resourceVersion seen before the retry
+	cs.ResourceVersion = latest.ResourceVersion
+	cs.Status = latest.Status
+	return nil
 }
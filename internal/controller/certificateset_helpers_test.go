@@ -0,0 +1,783 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+var _ = Describe("createOrUpdateSecret", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		r          *CertificateSetReconciler
+		desired    *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		existing := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "demo-argocd-cluster",
+				Namespace: "argocd",
+				Labels: map[string]string{
+					"argocd.argoproj.io/secret-type": "repository",
+					"other-controller/label":         "keep",
+				},
+				Annotations: map[string]string{
+					"team":                        "edited",
+					"other-controller/annotation": "keep",
+				},
+			},
+			Data: map[string][]byte{
+				"server":    []byte("https://api.example.com:6443"),
+				"unmanaged": []byte("keep"),
+			},
+		}
+		r, fakeClient = newTestReconciler(existing)
+
+		desired = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "demo-argocd-cluster",
+				Namespace:   "argocd",
+				Labels:      map[string]string{"argocd.argoproj.io/secret-type": "cluster", "env": "prod"},
+				Annotations: map[string]string{"team": "platform"},
+			},
+			Data: map[string][]byte{"server": []byte("https://api.example.com:6443")},
+		}
+	})
+
+	It("restores drifted labels and annotations without touching foreign ones", func() {
+		op, err := r.createOrUpdateSecret(ctx, desired, []string{"server"}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(op).To(Equal(controllerutil.OperationResultUpdated))
+
+		got := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(desired), got)).To(Succeed())
+		Expect(got.Labels).To(Equal(map[string]string{
+			"argocd.argoproj.io/secret-type": "cluster",
+			"env":                            "prod",
+			"other-controller/label":         "keep",
+		}))
+		Expect(got.Annotations).To(Equal(map[string]string{
+			"team":                        "platform",
+			"other-controller/annotation": "keep",
+		}))
+		Expect(got.Data).To(HaveKeyWithValue("unmanaged", []byte("keep")))
+	})
+
+	It("does not update a Secret that already matches", func() {
+		_, err := r.createOrUpdateSecret(ctx, desired, []string{"server"}, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		op, err := r.createOrUpdateSecret(ctx, desired, []string{"server"}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(op).To(Equal(controllerutil.OperationResultNone))
+
+		op, err = r.createOrUpdateSecret(ctx, desired, []string{"server"}, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(op).To(Equal(controllerutil.OperationResultUpdated))
+	})
+
+	It("re-renders the kubeconfig when only the CA changes", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec:       incloudiov1alpha1.CertificateSetSpec{Kubeconfig: true, KubeconfigEndpoint: "https://api.example.com:6443"},
+		}
+		render := func(caCert string) *corev1.Secret {
+			secret, err := buildKubeconfigSecret(cs, CertificateData{CACert: caCert, TLSCert: "Y2VydA==", TLSKey: "a2V5"}, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			return secret
+		}
+
+		_, err := r.createOrUpdateSecret(ctx, render("b2xkLWNh"), []string{kubeconfigSecretKey(cs)}, false)
+		Expect(err).NotTo(HaveOccurred())
+		op, err := r.createOrUpdateSecret(ctx, render("bmV3LWNh"), []string{kubeconfigSecretKey(cs)}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(op).To(Equal(controllerutil.OperationResultUpdated))
+
+		got := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: KubeconfigName(cs)}, got)).To(Succeed())
+		Expect(string(got.Data["value"])).To(ContainSubstring("certificate-authority-data: bmV3LWNh"))
+	})
+
+	It("refuses to change the type of an existing kubeconfig Secret", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "typed", Namespace: "default"},
+			Spec:       incloudiov1alpha1.CertificateSetSpec{Kubeconfig: true, KubeconfigEndpoint: "https://api.example.com:6443"},
+		}
+		certData := CertificateData{CACert: "Y2E=", TLSCert: "Y2VydA==", TLSKey: "a2V5"}
+		secret, err := buildKubeconfigSecret(cs, certData, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Type).To(Equal(corev1.SecretTypeOpaque))
+		_, err = r.createOrUpdateSecret(ctx, secret, []string{kubeconfigSecretKey(cs)}, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		cs.Spec.KubeconfigSecretType = "example.com/kubeconfig"
+		secret, err = buildKubeconfigSecret(cs, certData, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Type).To(Equal(corev1.SecretType("example.com/kubeconfig")))
+		_, err = r.createOrUpdateSecret(ctx, secret, []string{kubeconfigSecretKey(cs)}, false)
+		Expect(err).To(MatchError(ErrSecretTypeImmutable))
+		Expect(reasonForError(err, "DerivedSecretsFailed")).To(Equal("SecretTypeImmutable"))
+	})
+
+	It("updates server and config once kubeconfigEndpoint is set after being empty", func() {
+		stale := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "stale-argocd-cluster", Namespace: "argocd"},
+			Data: map[string][]byte{
+				"config": []byte("{}"),
+				"name":   []byte("stale"),
+				"server": []byte(""),
+			},
+		}
+		Expect(fakeClient.Create(ctx, stale)).To(Succeed())
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "stale", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				ArgocdCluster:      true,
+				ArgoCDNamespace:    "argocd",
+				KubeconfigEndpoint: "https://api.example.com:6443",
+			},
+		}
+		certData := CertificateData{CACert: "Y2E=", TLSCert: "Y2VydA==", TLSKey: "a2V5"}
+		secret, err := buildArgoCDClusterSecret(cs, certData, ArgoCDClusterSecrets(cs)[0])
+		Expect(err).NotTo(HaveOccurred())
+
+		op, err := r.createOrUpdateSecret(ctx, secret, argoCDClusterSecretKeys(cs), false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(op).To(Equal(controllerutil.OperationResultUpdated))
+
+		got := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(stale), got)).To(Succeed())
+		Expect(got.Data).To(HaveKeyWithValue("server", []byte("https://api.example.com:6443")))
+		Expect(got.Data).To(HaveKeyWithValue("config", secret.Data["config"]))
+	})
+
+	It("updates the Secret when a concurrent create wins the race", func() {
+		r, racing := newTestReconcilerWithInterceptor(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				// Another writer creates the Secret with stale data after our Get saw nothing
+				winner := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: obj.GetName(), Namespace: obj.GetNamespace()},
+					Data:       map[string][]byte{"server": []byte("https://stale.example.com:6443")},
+				}
+				Expect(c.Create(ctx, winner)).To(Succeed())
+				return c.Create(ctx, obj, opts...)
+			},
+		})
+
+		op, err := r.createOrUpdateSecret(ctx, desired, []string{"server"}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(op).To(Equal(controllerutil.OperationResultUpdated))
+
+		got := &corev1.Secret{}
+		Expect(racing.Get(ctx, client.ObjectKeyFromObject(desired), got)).To(Succeed())
+		Expect(got.Data).To(HaveKeyWithValue("server", []byte("https://api.example.com:6443")))
+		Expect(got.Labels).To(HaveKeyWithValue("env", "prod"))
+
+		op, err = r.createOrUpdateSecret(ctx, desired, []string{"server"}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(op).To(Equal(controllerutil.OperationResultNone))
+	})
+})
+
+var _ = Describe("targetNamespace", func() {
+	It("labels resources in the target namespace and removes them on cleanup", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "central", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:     incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:       incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				TargetNamespace: "tenant",
+			},
+		}
+		foreign := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "foreign", Namespace: "tenant"}}
+		root := &certmanagerv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: "root"}}
+
+		r, fakeClient := newTestReconciler(foreign, root)
+
+		Expect(r.reconcileCACertificates(ctx, cs)).To(Succeed())
+
+		ca := &certmanagerv1.Certificate{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "tenant", Name: CAName(cs)}, ca)).To(Succeed())
+		Expect(ca.OwnerReferences).To(BeEmpty())
+		Expect(ca.Labels).To(HaveKeyWithValue(OwnerNameLabel, "demo"))
+		Expect(ca.Labels).To(HaveKeyWithValue(OwnerNamespaceLabel, "central"))
+
+		Expect(r.cleanupTargetNamespace(ctx, cs)).To(Succeed())
+
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "tenant", Name: CAName(cs)}, ca)
+		Expect(err).To(HaveOccurred())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(foreign), &corev1.Secret{})).To(Succeed())
+	})
+
+	It("writes Certificates and Issuers without reading the target namespace from the cache", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "central", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:     incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:       incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				TargetNamespace: "tenant",
+			},
+		}
+		root := &certmanagerv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: "root"}}
+
+		r, fakeClient := newTestReconciler(root)
+		// In --namespace mode the cache does not cover the target namespace
+		r.Client = interceptor.NewClient(fakeClient, interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if key.Namespace == "tenant" {
+					return errors.New("unable to get: tenant/" + key.Name + " because of unknown namespace for the cache")
+				}
+				return c.Get(ctx, key, obj, opts...)
+			},
+		})
+
+		Expect(r.reconcileCACertificates(ctx, cs)).To(Succeed())
+		Expect(r.reconcileClientCertificates(ctx, cs)).To(Succeed())
+		cs.Labels = map[string]string{"team": "a"}
+		Expect(r.reconcileCACertificates(ctx, cs)).To(Succeed())
+		Expect(r.reconcileClientCertificates(ctx, cs)).To(Succeed())
+
+		ca := &certmanagerv1.Certificate{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "tenant", Name: CAName(cs)}, ca)).To(Succeed())
+		Expect(ca.Labels).To(HaveKeyWithValue("team", "a"))
+		issuers := &certmanagerv1.IssuerList{}
+		Expect(fakeClient.List(ctx, issuers, client.InNamespace("tenant"))).To(Succeed())
+		Expect(issuers.Items).NotTo(BeEmpty())
+	})
+})
+
+var _ = Describe("getCertificateReadyCondition", func() {
+	It("appends the Issuing failure message to the Ready message", func() {
+		ctx := context.Background()
+
+		cert := &certmanagerv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-ca", Namespace: "default"},
+			Status: certmanagerv1.CertificateStatus{
+				Conditions: []certmanagerv1.CertificateCondition{
+					{Type: certmanagerv1.CertificateConditionReady, Status: cmmeta.ConditionFalse, Reason: "DoesNotExist", Message: "Issuing certificate as Secret does not exist"},
+					{Type: certmanagerv1.CertificateConditionIssuing, Status: cmmeta.ConditionFalse, Reason: "Failed", Message: "The certificate request has failed to complete and will be retried: issuer rejected the request"},
+				},
+			},
+		}
+
+		r, _ := newTestReconciler(cert)
+
+		status, reason, message, err := r.getCertificateReadyCondition(ctx, "default", "demo-ca")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status).To(Equal(metav1.ConditionFalse))
+		Expect(reason).To(Equal("DoesNotExist"))
+		Expect(message).To(Equal("Issuing certificate as Secret does not exist; issuing failed: The certificate request has failed to complete and will be retried: issuer rejected the request"))
+		Expect(notReadyMessage("Certificate", "demo-ca", message)).To(HavePrefix("Certificate demo-ca is not ready: Issuing certificate"))
+	})
+})
+
+var _ = Describe("setDegraded", func() {
+	It("flips a stale Ready=True to False with the Degraded reason", func() {
+		cs := &incloudiov1alpha1.CertificateSet{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", Generation: 2}}
+		r := &CertificateSetReconciler{}
+		r.setCondition(cs, ConditionTypeReady, metav1.ConditionTrue, "AllResourcesReady", "All certificate resources created and ready")
+
+		r.setDegraded(cs, "OrphanCleanupFailed", "boom")
+
+		ready := meta.FindStatusCondition(cs.Status.Conditions, ConditionTypeReady)
+		Expect(ready).NotTo(BeNil())
+		Expect(ready.Status).To(Equal(metav1.ConditionFalse))
+		Expect(ready.Reason).To(Equal("OrphanCleanupFailed"))
+		degraded := meta.FindStatusCondition(cs.Status.Conditions, ConditionTypeDegraded)
+		Expect(degraded).NotTo(BeNil())
+		Expect(degraded.Status).To(Equal(metav1.ConditionTrue))
+		Expect(degraded.Message).To(Equal("boom"))
+		Expect(cs.Status.Phase).To(Equal(incloudiov1alpha1.PhaseDegraded))
+	})
+})
+
+var _ = Describe("syncCertificateExpiry", func() {
+	It("reports the super-admin renewal time and clears it without a super-admin certificate", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentClient,
+				Kubeconfig:  true,
+			},
+		}
+		notAfter := metav1.NewTime(time.Date(2026, 1, 15, 10, 0, 0, 0, time.UTC))
+		renewal := metav1.NewTime(time.Date(2025, 9, 17, 10, 0, 0, 0, time.UTC))
+		superAdmin := &certmanagerv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: SuperAdminName(cs), Namespace: "default"},
+			Status:     certmanagerv1.CertificateStatus{NotAfter: &notAfter, RenewalTime: &renewal},
+		}
+
+		r, _ := newTestReconciler(superAdmin)
+
+		Expect(r.syncCertificateExpiry(ctx, cs)).To(Succeed())
+		Expect(cs.Status.CAExpiry).To(BeNil())
+		Expect(cs.Status.ClientExpiry.Equal(&notAfter)).To(BeTrue())
+		Expect(cs.Status.NextClientRenewal.Equal(&renewal)).To(BeTrue())
+
+		cs.Spec.Kubeconfig = false
+		Expect(r.syncCertificateExpiry(ctx, cs)).To(Succeed())
+		Expect(cs.Status.NextClientRenewal).To(BeNil())
+	})
+})
+
+var _ = Describe("orphanGeneratedSecrets", func() {
+	It("removes owner references and owner labels from generated Secrets", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec:       incloudiov1alpha1.CertificateSetSpec{OrphanSecretsOnDelete: true},
+		}
+		kubeconfig := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: KubeconfigName(cs), Namespace: "default"}}
+		Expect(controllerutil.SetControllerReference(cs, kubeconfig, testScheme)).To(Succeed())
+		ca := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      CAName(cs),
+			Namespace: "tenant",
+			Labels:    map[string]string{OwnerNameLabel: "demo", OwnerNamespaceLabel: "default", "team": "a"},
+		}}
+		cs.Status.GeneratedSecrets = []incloudiov1alpha1.GeneratedSecret{
+			{Purpose: incloudiov1alpha1.SecretPurposeKubeconfig, Namespace: "default", Name: kubeconfig.Name},
+			{Purpose: incloudiov1alpha1.SecretPurposeCA, Namespace: "tenant", Name: ca.Name},
+			{Purpose: incloudiov1alpha1.SecretPurposeSuperAdmin, Namespace: "default", Name: SuperAdminName(cs)},
+		}
+
+		r, fakeClient := newTestReconciler(cs, kubeconfig, ca)
+
+		Expect(r.orphanGeneratedSecrets(ctx, cs)).To(Succeed())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(kubeconfig), kubeconfig)).To(Succeed())
+		Expect(kubeconfig.OwnerReferences).To(BeEmpty())
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(ca), ca)).To(Succeed())
+		Expect(ca.Labels).To(Equal(map[string]string{"team": "a"}))
+	})
+})
+
+var _ = Describe("patchStatus", func() {
+	It("re-applies the whole status after a concurrent write", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"}}
+		meta.SetStatusCondition(&cs.Status.Conditions, metav1.Condition{Type: "StaleCertificateReady", Status: metav1.ConditionTrue, Reason: "Ready"})
+
+		patches := 0
+		r, fakeClient := newTestReconcilerWithInterceptor(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+				patches++
+				if patches == 1 {
+					// Another writer updates the CertificateSet between our read and the status patch
+					other := &incloudiov1alpha1.CertificateSet{}
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), other)).To(Succeed())
+					other.Labels = map[string]string{"team": "a"}
+					Expect(c.Update(ctx, other)).To(Succeed())
+				}
+				return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+			},
+		}, cs)
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cs), cs)).To(Succeed())
+
+		original := cs.DeepCopy()
+		meta.RemoveStatusCondition(&cs.Status.Conditions, "StaleCertificateReady")
+		r.setCondition(cs, ConditionTypeReady, metav1.ConditionTrue, "AllResourcesReady", "All certificate resources created and ready")
+
+		Expect(r.patchStatus(ctx, cs, original)).To(Succeed())
+		Expect(patches).To(Equal(2))
+
+		got := &incloudiov1alpha1.CertificateSet{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cs), got)).To(Succeed())
+		Expect(got.Labels).To(HaveKeyWithValue("team", "a"))
+		Expect(got.Status.Conditions).To(HaveLen(1))
+		Expect(meta.IsStatusConditionTrue(got.Status.Conditions, ConditionTypeReady)).To(BeTrue())
+
+		// The retried patch hands its resourceVersion back, so the next write of cs does not conflict
+		Expect(cs.ResourceVersion).To(Equal(got.ResourceVersion))
+		Expect(fakeClient.Update(ctx, cs)).To(Succeed())
+	})
+})
+
+var _ = Describe("Issuer scope swap", func() {
+	It("removes the issuer of the previous scope and keeps the one in use", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:   incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				Kubeconfig:  true,
+			},
+		}
+		r, fakeClient := newTestReconciler()
+		r.ClusterResourceNamespace = "default"
+		issuerKey := types.NamespacedName{Namespace: "default", Name: CAName(cs)}
+		clusterIssuerKey := types.NamespacedName{Name: ClusterIssuerName(cs)}
+
+		Expect(r.reconcileClientCertificates(ctx, cs)).To(Succeed())
+		Expect(r.cleanupOrphanedResources(ctx, cs)).To(Succeed())
+		Expect(fakeClient.Get(ctx, issuerKey, &certmanagerv1.Issuer{})).To(Succeed())
+
+		cs.Spec.IssuerScope = incloudiov1alpha1.IssuerScopeClusterIssuer
+		Expect(r.reconcileClientCertificates(ctx, cs)).To(Succeed())
+		Expect(r.cleanupOrphanedResources(ctx, cs)).To(Succeed())
+		Expect(apierrors.IsNotFound(fakeClient.Get(ctx, issuerKey, &certmanagerv1.Issuer{}))).To(BeTrue())
+		clusterIssuer := &certmanagerv1.ClusterIssuer{}
+		Expect(fakeClient.Get(ctx, clusterIssuerKey, clusterIssuer)).To(Succeed())
+		Expect(isOwnedBy(cs, clusterIssuer)).To(BeTrue())
+
+		cs.Spec.IssuerScope = incloudiov1alpha1.IssuerScopeIssuer
+		Expect(r.reconcileClientCertificates(ctx, cs)).To(Succeed())
+		Expect(r.cleanupOrphanedResources(ctx, cs)).To(Succeed())
+		Expect(apierrors.IsNotFound(fakeClient.Get(ctx, clusterIssuerKey, &certmanagerv1.ClusterIssuer{}))).To(BeTrue())
+		Expect(fakeClient.Get(ctx, issuerKey, &certmanagerv1.Issuer{})).To(Succeed())
+	})
+
+	It("refuses a ClusterIssuer whose CA Secret is outside the cert-manager cluster resource namespace", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:   incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				IssuerScope: incloudiov1alpha1.IssuerScopeClusterIssuer,
+				Kubeconfig:  true,
+			},
+		}
+		r, fakeClient := newTestReconciler()
+
+		err := r.reconcileClientCertificates(ctx, cs)
+		Expect(err).To(MatchError(ErrClusterIssuerNamespace))
+		Expect(err).To(MatchError(ContainSubstring(`from "cert-manager", but it is in "default"`)))
+		Expect(reasonForError(err, "ClientCertificatesFailed")).To(Equal("ClusterIssuerNamespaceMismatch"))
+		Expect(apierrors.IsNotFound(fakeClient.Get(ctx, types.NamespacedName{Name: ClusterIssuerName(cs)}, &certmanagerv1.ClusterIssuer{}))).To(BeTrue())
+	})
+
+	It("does not take over a foreign ClusterIssuer with the same name", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:   incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				IssuerScope: incloudiov1alpha1.IssuerScopeClusterIssuer,
+				Kubeconfig:  true,
+			},
+		}
+		foreign := &certmanagerv1.ClusterIssuer{
+			ObjectMeta: metav1.ObjectMeta{Name: ClusterIssuerName(cs), Labels: map[string]string{"team": "platform"}},
+			Spec: certmanagerv1.IssuerSpec{IssuerConfig: certmanagerv1.IssuerConfig{
+				CA: &certmanagerv1.CAIssuer{SecretName: "platform-ca"},
+			}},
+		}
+		controllerutil.AddFinalizer(cs, DefaultFinalizerName)
+		r, fakeClient := newTestReconciler(cs, foreign)
+		r.ClusterResourceNamespace = "default"
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cs), cs)).To(Succeed())
+
+		Expect(r.reconcileClientCertificates(ctx, cs)).To(MatchError(ErrResourceConflict))
+
+		Expect(r.reconcileDelete(ctx, cs)).Error().NotTo(HaveOccurred())
+		clusterIssuer := &certmanagerv1.ClusterIssuer{}
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(foreign), clusterIssuer)).To(Succeed())
+		Expect(clusterIssuer.Labels).To(Equal(map[string]string{"team": "platform"}))
+		Expect(clusterIssuer.Spec.CA.SecretName).To(Equal("platform-ca"))
+	})
+})
+
+var _ = Describe("checkAdoptable", func() {
+	cs := &incloudiov1alpha1.CertificateSet{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"}}
+	other := &incloudiov1alpha1.CertificateSet{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "other-uid"}}
+
+	DescribeTable("existing Certificate",
+		func(controller *incloudiov1alpha1.CertificateSet, adopt bool, matchErr gomegatypes.GomegaMatcher) {
+			cert := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "demo-ca", Namespace: "default", ResourceVersion: "1"}}
+			if controller != nil {
+				Expect(controllerutil.SetControllerReference(controller, cert, testScheme)).To(Succeed())
+			}
+			adopter := cs.DeepCopy()
+			if adopt {
+				adopter.Annotations = map[string]string{AdoptAnnotation: "true"}
+			}
+			Expect(checkAdoptable(adopter, cert)).To(matchErr)
+		},
+		Entry("owned by the CertificateSet is updated", cs, false, Succeed()),
+		Entry("without a controller is refused without the adopt annotation", nil, false, MatchError(ContainSubstring("set annotation"))),
+		Entry("without a controller is adopted with the adopt annotation", nil, true, Succeed()),
+		Entry("controlled by someone else is refused even with the adopt annotation", other, true, MatchError(ContainSubstring("is controlled by CertificateSet other"))),
+	)
+
+	It("reports refusals as ResourceConflict", func() {
+		cert := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "demo-ca", Namespace: "default", ResourceVersion: "1"}}
+		err := checkAdoptable(cs, cert)
+		Expect(err).To(MatchError(ErrResourceConflict))
+		Expect(reasonForError(err, "CertificateFailed")).To(Equal("ResourceConflict"))
+	})
+})
+
+var _ = Describe("cleanupOrphanedResources", func() {
+	var cs *incloudiov1alpha1.CertificateSet
+
+	BeforeEach(func() {
+		cs = &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:   incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+			},
+		}
+	})
+
+	// objects returns one resource of every kind that cleanup removes once cs no longer needs it
+	objects := func() []client.Object {
+		return []client.Object{
+			&certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: ETCDName(cs), Namespace: "default"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: ETCDName(cs), Namespace: "default", Annotations: map[string]string{certmanagerv1.CertificateNameKey: ETCDName(cs)}}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: KubeconfigName(cs), Namespace: "default"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: FullChainName(cs), Namespace: "default"}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ClusterInfoName(cs), Namespace: "default"}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: CACertConfigMapName(cs), Namespace: "default"}},
+			&certmanagerv1.Issuer{ObjectMeta: metav1.ObjectMeta{Name: CAName(cs), Namespace: "default"}},
+			&certmanagerv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: ClusterIssuerName(cs)}},
+		}
+	}
+
+	It("deletes the resources the CertificateSet owns", func() {
+		ctx := context.Background()
+
+		owned := objects()
+		for _, obj := range owned {
+			Expect((&CertificateSetReconciler{Scheme: testScheme}).setOwner(cs, obj)).To(Succeed())
+		}
+		r, fakeClient := newTestReconciler(owned...)
+
+		Expect(r.cleanupOrphanedResources(ctx, cs)).To(Succeed())
+
+		for _, obj := range owned {
+			err := fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+			Expect(apierrors.IsNotFound(err)).To(BeTrue(), "%T %s should be deleted", obj, obj.GetName())
+		}
+	})
+
+	It("leaves same-named resources of others alone", func() {
+		ctx := context.Background()
+
+		foreign := objects()
+		// A cert-manager Secret is only deleted with a Certificate this CertificateSet owned
+		foreign = append(foreign, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:        ProxyName(cs),
+			Namespace:   "default",
+			Annotations: map[string]string{certmanagerv1.CertificateNameKey: ProxyName(cs)},
+		}})
+		r, fakeClient := newTestReconciler(foreign...)
+
+		Expect(r.cleanupOrphanedResources(ctx, cs)).To(Succeed())
+
+		for _, obj := range foreign {
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed(), "%T %s should be kept", obj, obj.GetName())
+		}
+	})
+})
+
+var _ = Describe("AllManagedResources", func() {
+	It("lists the ArgoCD cluster Secret in its own namespace and matches PlannedResources", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:     incloudiov1alpha1.EnvironmentClient,
+				Kubeconfig:      true,
+				ArgocdCluster:   true,
+				ArgoCDNamespace: "argo",
+			},
+		}
+
+		resources := AllManagedResources(cs)
+		Expect(resources).To(ContainElements(
+			ManagedResource{Kind: "Certificate", Name: "demo-ca", Namespace: "default", Purpose: "ca"},
+			ManagedResource{Kind: "Secret", Name: "demo-super-admin", Namespace: "default", Purpose: "super-admin"},
+			ManagedResource{Kind: "Issuer", Name: "demo-ca", Namespace: "default", Purpose: "ca"},
+			ManagedResource{Kind: "Secret", Name: "demo-argocd-cluster", Namespace: "argo", Purpose: "argocd-cluster"},
+		))
+		Expect(PlannedResources(cs)).To(HaveLen(len(resources)))
+	})
+})
+
+var _ = Describe("childChangedPredicate", func() {
+	update := func(oldObj, newObj client.Object) bool {
+		return childChangedPredicate().Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})
+	}
+	certificate := func(ready cmmeta.ConditionStatus) *certmanagerv1.Certificate {
+		cert := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "demo-ca", Generation: 1}}
+		cert.Status.Conditions = []certmanagerv1.CertificateCondition{{Type: certmanagerv1.CertificateConditionReady, Status: ready}}
+		return cert
+	}
+
+	It("ignores Certificate status updates that keep Ready", func() {
+		oldCert, newCert := certificate(cmmeta.ConditionTrue), certificate(cmmeta.ConditionTrue)
+		revision := 2
+		newCert.Status.Revision = &revision
+		Expect(update(oldCert, newCert)).To(BeFalse())
+	})
+
+	It("passes Ready transitions, renewals and spec changes", func() {
+		Expect(update(certificate(cmmeta.ConditionFalse), certificate(cmmeta.ConditionTrue))).To(BeTrue())
+
+		renewed := certificate(cmmeta.ConditionTrue)
+		renewed.Status.NotAfter = &metav1.Time{}
+		Expect(update(certificate(cmmeta.ConditionTrue), renewed)).To(BeTrue())
+
+		edited := certificate(cmmeta.ConditionTrue)
+		edited.Generation = 2
+		Expect(update(certificate(cmmeta.ConditionTrue), edited)).To(BeTrue())
+	})
+
+	It("passes Secret data and metadata changes only", func() {
+		oldSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"team": "a"}},
+			Data:       map[string][]byte{"tls.crt": []byte("a")},
+		}
+		touched := oldSecret.DeepCopy()
+		touched.ResourceVersion = "2"
+		Expect(update(oldSecret, touched)).To(BeFalse())
+
+		changed := oldSecret.DeepCopy()
+		changed.Data["tls.crt"] = []byte("b")
+		Expect(update(oldSecret, changed)).To(BeTrue())
+
+		relabelled := oldSecret.DeepCopy()
+		relabelled.Labels["team"] = "b"
+		Expect(update(oldSecret, relabelled)).To(BeTrue())
+
+		annotated := oldSecret.DeepCopy()
+		annotated.Annotations = map[string]string{"note": "edited"}
+		Expect(update(oldSecret, annotated)).To(BeTrue())
+	})
+})
+
+var _ = Describe("certificateSecretDataPredicate", func() {
+	update := func(oldObj, newObj client.Object) bool {
+		return certificateSecretDataPredicate().Update(event.UpdateEvent{ObjectOld: oldObj, ObjectNew: newObj})
+	}
+	issued := &corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("ca-1"), "tls.crt": []byte("crt"), "tls.key": []byte("key")}}
+
+	It("passes when the Secret is issued or its CA changes", func() {
+		Expect(update(&corev1.Secret{}, issued)).To(BeTrue())
+
+		rotated := issued.DeepCopy()
+		rotated.Data["ca.crt"] = []byte("ca-2")
+		Expect(update(issued, rotated)).To(BeTrue())
+	})
+
+	It("passes when the leaf certificate is renewed", func() {
+		renewed := issued.DeepCopy()
+		renewed.Data["tls.crt"] = []byte("crt-2")
+		Expect(update(issued, renewed)).To(BeTrue())
+	})
+
+	It("ignores updates that keep the certificate data", func() {
+		relabelled := issued.DeepCopy()
+		relabelled.Labels = map[string]string{"team": "a"}
+		Expect(update(issued, relabelled)).To(BeFalse())
+
+		rekeyed := issued.DeepCopy()
+		rekeyed.Data["tls.key"] = []byte("key-2")
+		Expect(update(issued, rekeyed)).To(BeFalse())
+	})
+
+	It("ignores Secrets that are not issued yet", func() {
+		partial := &corev1.Secret{Data: map[string][]byte{"ca.crt": []byte("ca-1"), "tls.crt": []byte("crt")}}
+		Expect(update(&corev1.Secret{}, partial)).To(BeFalse())
+		Expect(certificateSecretDataPredicate().Create(event.CreateEvent{Object: partial})).To(BeFalse())
+	})
+
+	It("passes creates of issued Secrets and drops deletes", func() {
+		Expect(certificateSecretDataPredicate().Create(event.CreateEvent{Object: issued})).To(BeTrue())
+		Expect(certificateSecretDataPredicate().Delete(event.DeleteEvent{Object: issued})).To(BeFalse())
+	})
+})
+
+var _ = Describe("certificateSecretToCertificateSet", func() {
+	ctx := context.Background()
+	secretFor := func(certName string) *corev1.Secret {
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "demo-tls", Namespace: "default"}}
+		if certName != "" {
+			secret.Annotations = map[string]string{certmanagerv1.CertificateNameKey: certName}
+		}
+		return secret
+	}
+
+	It("maps to the CertificateSet controlling the Certificate", func() {
+		cs := &incloudiov1alpha1.CertificateSet{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"}}
+		cert := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "demo-cert", Namespace: "default"}}
+		Expect(controllerutil.SetControllerReference(cs, cert, testScheme)).To(Succeed())
+		r, _ := newTestReconciler(cert)
+
+		Expect(r.certificateSecretToCertificateSet(ctx, secretFor("demo-cert"))).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "demo"}}))
+	})
+
+	It("maps to the CertificateSet named in the Certificate owner labels", func() {
+		cert := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-cert",
+			Namespace: "default",
+			Labels:    map[string]string{OwnerNameLabel: "demo", OwnerNamespaceLabel: "platform"},
+		}}
+		r, _ := newTestReconciler(cert)
+
+		Expect(r.certificateSecretToCertificateSet(ctx, secretFor("demo-cert"))).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "platform", Name: "demo"}}))
+	})
+
+	It("maps nothing without an owned Certificate", func() {
+		foreign := &certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "foreign-cert", Namespace: "default"}}
+		r, _ := newTestReconciler(foreign)
+
+		Expect(r.certificateSecretToCertificateSet(ctx, secretFor(""))).To(BeEmpty())
+		Expect(r.certificateSecretToCertificateSet(ctx, secretFor("missing-cert"))).To(BeEmpty())
+		Expect(r.certificateSecretToCertificateSet(ctx, secretFor("foreign-cert"))).To(BeEmpty())
+	})
+})
@@ -18,103 +18,542 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"golang.org/x/sync/errgroup"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
-	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	incloudiov1alpha1 "certificate-set/api/v1alpha1"
 )
 
+// rotateCA deletes the CA Certificate and Secret so that they are re-created with a new key, and the
+// Secrets of the client certificates signed by the CA so that cert-manager re-issues them from the new CA
+func (r *CertificateSetReconciler) rotateCA(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	log := logf.FromContext(ctx)
+	log.Info("Rotating CA", "name", CAName(cs), "rotation", cs.Annotations[RotateCAAnnotation])
+
+	if err := r.deleteCertificateIfExists(ctx, TargetNamespace(cs), CAName(cs)); err != nil {
+		return fmt.Errorf("failed to delete CA Certificate: %w", err)
+	}
+	if err := r.deleteSecretIfExists(ctx, TargetNamespace(cs), CAName(cs)); err != nil {
+		return fmt.Errorf("failed to delete CA Secret: %w", err)
+	}
+
+	signed := []string{SuperAdminName(cs)}
+	for _, client := range cs.Spec.ClientCertificates {
+		signed = append(signed, ClientCertificateName(cs, client.Name))
+	}
+	for _, name := range signed {
+		if err := r.deleteSecretIfExists(ctx, TargetNamespace(cs), name); err != nil {
+			return fmt.Errorf("failed to delete Secret %s signed by the CA: %w", name, err)
+		}
+	}
+	return nil
+}
+
 // reconcileCACertificates creates the main CA certificate and additional CA certificates
 // for system/infra environments (ETCD, Proxy, OIDC).
-func (r *CertificateSetReconciler) reconcileCACertificates(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
-	// Main CA Certificate (always created)
-	if err := r.createOrUpdateCertificate(ctx, cs, buildCACertificate(cs)); err != nil {
-		return fmt.Errorf("failed to create CA Certificate: %w", err)
-	}
+func (r *CertificateSetReconciler) reconcileCACertificates(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) (err error) {
+	ctx, span := tracer.Start(ctx, "reconcileCACertificates")
+	defer func() { endSpan(span, err) }()
 
-	// Additional CA certificates for system/infra environments
+	// The main CA and the system/infra CA certificates are independent, so they are created concurrently
+	type caTarget struct {
+		kind    string
+		purpose incloudiov1alpha1.SecretPurpose
+		cert    *certmanagerv1.Certificate
+	}
+	var targets []caTarget
+	if !usesExistingCA(cs) {
+		targets = append(targets, caTarget{"CA", incloudiov1alpha1.SecretPurposeCA, buildCACertificate(cs)})
+	}
+	if generateETCD(cs) {
+		targets = append(targets, caTarget{"ETCD", incloudiov1alpha1.SecretPurposeETCD, buildETCDCertificate(cs)})
+	}
+	if generateProxy(cs) {
+		targets = append(targets, caTarget{"Proxy", incloudiov1alpha1.SecretPurposeProxy, buildProxyCertificate(cs)})
+	}
 	if isSystemOrInfra(cs.Spec.Environment) {
-		if err := r.createOrUpdateCertificate(ctx, cs, buildETCDCertificate(cs)); err != nil {
-			return fmt.Errorf("failed to create ETCD Certificate: %w", err)
-		}
+		targets = append(targets, caTarget{"OIDC", incloudiov1alpha1.SecretPurposeCAOIDC, buildOIDCCertificate(cs)})
+	}
+	// With an existing CA a client CertificateSet issues nothing from spec.issuerRef
+	if len(targets) == 0 {
+		return nil
+	}
 
-		if err := r.createOrUpdateCertificate(ctx, cs, buildProxyCertificate(cs)); err != nil {
-			return fmt.Errorf("failed to create Proxy Certificate: %w", err)
+	// A self-signed CA comes from the controller's own Issuer, so spec.issuerRef is not consulted
+	if usesSelfSignedCA(cs) {
+		if err := r.createOrUpdateIssuer(ctx, cs, buildSelfSignedIssuer(cs)); err != nil {
+			return fmt.Errorf("failed to create SelfSigned Issuer: %w", err)
 		}
+	} else if err := r.checkIssuerRefExists(ctx, cs); err != nil {
+		// Preflight: a CA Certificate pointing to a missing issuer never becomes Ready
+		return err
+	}
 
-		if err := r.createOrUpdateCertificate(ctx, cs, buildOIDCCertificate(cs)); err != nil {
-			return fmt.Errorf("failed to create OIDC Certificate: %w", err)
+	errs := make([]error, len(targets))
+	var g errgroup.Group
+	for i, t := range targets {
+		g.Go(func() error {
+			if err := r.createOrUpdateCertificate(ctx, cs, t.cert); err != nil {
+				errs[i] = fmt.Errorf("failed to create %s Certificate: %w", t.kind, err)
+			}
+			return errs[i]
+		})
+	}
+	// Every failure is reported below, not only the first one returned by Wait
+	_ = g.Wait()
+
+	// Status is only touched after all goroutines are done
+	for i, t := range targets {
+		if errs[i] == nil {
+			r.setGeneratedSecret(cs, t.purpose, t.cert.Namespace, t.cert.Spec.SecretName)
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
-// reconcileClientCertificates creates the Issuer (using CA) and super-admin certificate.
-// This is needed when kubeconfig or argocd cluster secret is enabled.
-func (r *CertificateSetReconciler) reconcileClientCertificates(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+// reconcileClientCertificates creates the Issuer (using CA), the super-admin certificate (when
+// kubeconfig or argocd cluster secret is enabled) and the additional client certificates.
+func (r *CertificateSetReconciler) reconcileClientCertificates(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) (err error) {
+	ctx, span := tracer.Start(ctx, "reconcileClientCertificates")
+	defer func() { endSpan(span, err) }()
+
 	log := logf.FromContext(ctx)
 
-	// Create Issuer that uses the CA certificate
-	issuer := buildIssuer(cs)
-	if err := r.createOrUpdateIssuer(ctx, cs, issuer); err != nil {
-		return fmt.Errorf("failed to create Issuer: %w", err)
+	// Create Issuer (or ClusterIssuer) that uses the CA certificate
+	var issuerName string
+	if usesClusterIssuer(cs) {
+		clusterIssuer := buildClusterIssuer(cs)
+		if namespace := r.clusterResourceNamespace(); TargetNamespace(cs) != namespace {
+			return fmt.Errorf("%w: ClusterIssuer %s reads Secret %s from %q, but it is in %q",
+				ErrClusterIssuerNamespace, clusterIssuer.Name, clusterIssuer.Spec.CA.SecretName, namespace, TargetNamespace(cs))
+		}
+		if err := r.createOrUpdateClusterIssuer(ctx, cs, clusterIssuer); err != nil {
+			return fmt.Errorf("failed to create ClusterIssuer: %w", err)
+		}
+		issuerName = clusterIssuer.Name
+	} else {
+		issuer := buildIssuer(cs)
+		if err := r.createOrUpdateIssuer(ctx, cs, issuer); err != nil {
+			return fmt.Errorf("failed to create Issuer: %w", err)
+		}
+		issuerName = issuer.Name
 	}
 
 	log.Info("Creating client certificates")
 
+	if needsSuperAdmin(cs) && cs.Spec.LiteralSubject != "" {
+		if err := validateLiteralSubject(cs.Spec.LiteralSubject); err != nil {
+			return err
+		}
+	}
+
 	// Create super-admin Certificate using the Issuer
-	if err := r.createOrUpdateCertificate(ctx, cs, buildSuperAdminCertificate(cs, issuer.Name)); err != nil {
-		return fmt.Errorf("failed to create super-admin Certificate: %w", err)
+	if needsSuperAdmin(cs) {
+		superAdminCert := buildSuperAdminCertificate(cs, issuerName)
+		if err := r.createOrUpdateCertificate(ctx, cs, superAdminCert); err != nil {
+			return fmt.Errorf("failed to create super-admin Certificate: %w", err)
+		}
+		r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeSuperAdmin, superAdminCert.Namespace, superAdminCert.Spec.SecretName)
+	}
+
+	// Create additional client Certificates using the same Issuer
+	for _, client := range cs.Spec.ClientCertificates {
+		clientCert := buildAdditionalClientCertificate(cs, issuerName, client)
+		if err := r.createOrUpdateCertificate(ctx, cs, clientCert); err != nil {
+			return fmt.Errorf("failed to create client Certificate %s: %w", clientCert.Name, err)
+		}
+		r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeClientCertificate, clientCert.Namespace, clientCert.Spec.SecretName)
+	}
+
+	return nil
+}
+
+// reconcileETCDLeafCertificates creates the ETCD Issuer and the etcd-server and etcd-peer certificates signed by it
+func (r *CertificateSetReconciler) reconcileETCDLeafCertificates(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	if err := r.createOrUpdateIssuer(ctx, cs, buildETCDIssuer(cs)); err != nil {
+		return fmt.Errorf("failed to create ETCD Issuer: %w", err)
+	}
+
+	leaves := []struct {
+		purpose incloudiov1alpha1.SecretPurpose
+		name    string
+	}{
+		{incloudiov1alpha1.SecretPurposeETCDServer, ETCDServerName(cs)},
+		{incloudiov1alpha1.SecretPurposeETCDPeer, ETCDPeerName(cs)},
+	}
+	for _, leaf := range leaves {
+		cert := buildETCDLeafCertificate(cs, leaf.name)
+		if err := r.createOrUpdateCertificate(ctx, cs, cert); err != nil {
+			return fmt.Errorf("failed to create etcd Certificate %s: %w", cert.Name, err)
+		}
+		r.setGeneratedSecret(cs, leaf.purpose, cert.Namespace, cert.Spec.SecretName)
+	}
+	return nil
+}
+
+// reconcileFrontProxyClientCertificate creates the Proxy Issuer and the front-proxy client certificate signed by it
+func (r *CertificateSetReconciler) reconcileFrontProxyClientCertificate(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	if err := r.createOrUpdateIssuer(ctx, cs, buildProxyIssuer(cs)); err != nil {
+		return fmt.Errorf("failed to create Proxy Issuer: %w", err)
+	}
+
+	cert := buildFrontProxyClientCertificate(cs)
+	if err := r.createOrUpdateCertificate(ctx, cs, cert); err != nil {
+		return fmt.Errorf("failed to create front-proxy client Certificate %s: %w", cert.Name, err)
+	}
+	r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeFrontProxyClient, cert.Namespace, cert.Spec.SecretName)
+	return nil
+}
+
+// reconcileClientKubeconfigs creates a kubeconfig Secret for every additional client certificate
+// whose Secret has been issued. Pending ones are picked up once cert-manager writes their Secret.
+func (r *CertificateSetReconciler) reconcileClientKubeconfigs(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	for _, client := range cs.Spec.ClientCertificates {
+		certName := ClientCertificateName(cs, client.Name)
+		ready, err := r.isSecretReady(ctx, TargetNamespace(cs), certName)
+		if err != nil {
+			return err
+		}
+		if !ready {
+			continue
+		}
+
+		certData, err := r.getCertificateData(ctx, TargetNamespace(cs), certName)
+		if err != nil {
+			return fmt.Errorf("failed to get certificate data from Secret %s: %w", certName, err)
+		}
+
+		kubeconfigSecret, err := buildClientKubeconfigSecret(cs, client, certData)
+		if err != nil {
+			return fmt.Errorf("failed to build kubeconfig Secret for client %s: %w", client.Name, err)
+		}
+		if err := r.setOwner(cs, kubeconfigSecret); err != nil {
+			return fmt.Errorf("failed to set owner reference on kubeconfig Secret: %w", err)
+		}
+
+		op, err := r.createOrUpdateSecret(ctx, kubeconfigSecret, []string{kubeconfigSecretKey(cs)}, resyncRequested(cs))
+		if err != nil {
+			return fmt.Errorf("failed to create kubeconfig Secret %s: %w", kubeconfigSecret.Name, err)
+		}
+		r.recordSecretEvent(cs, kubeconfigSecret, op)
+		r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeClientKubeconfig, kubeconfigSecret.Namespace, kubeconfigSecret.Name)
+	}
+
+	return nil
+}
+
+// reconcileKubeconfigMirrors copies the kubeconfig Secret to every spec.kubeconfigMirrorNamespaces namespace
+// and removes the copies from namespaces dropped from the list
+func (r *CertificateSetReconciler) reconcileKubeconfigMirrors(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, source *corev1.Secret) error {
+	keep := KubeconfigMirrorSecrets(cs)
+	for _, key := range keep {
+		if err := r.APIReader.Get(ctx, types.NamespacedName{Name: key.Namespace}, &corev1.Namespace{}); err != nil {
+			if apierrors.IsNotFound(err) {
+				return fmt.Errorf("%w: %q does not exist", ErrKubeconfigMirrorNamespaceNotFound, key.Namespace)
+			}
+			return fmt.Errorf("failed to check kubeconfig mirror namespace: %w", err)
+		}
+
+		mirror := buildKubeconfigMirrorSecret(cs, source, key)
+		if err := r.setOwner(cs, mirror); err != nil {
+			return fmt.Errorf("failed to set owner on kubeconfig mirror Secret: %w", err)
+		}
+		op, err := r.createOrUpdateSecret(ctx, mirror, []string{kubeconfigSecretKey(cs)}, resyncRequested(cs))
+		if err != nil {
+			return fmt.Errorf("failed to create kubeconfig mirror Secret %s/%s: %w", mirror.Namespace, mirror.Name, err)
+		}
+		r.recordSecretEvent(cs, mirror, op)
+		r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeKubeconfigMirror, mirror.Namespace, mirror.Name)
+	}
+
+	if err := r.cleanupKubeconfigMirrorSecrets(ctx, cs, keep); err != nil {
+		return fmt.Errorf("failed to clean up stale kubeconfig mirror Secrets: %w", err)
+	}
+	return nil
+}
+
+// reconcileCABundle publishes the CA certificate as ${name}-ca-bundle. The CA certificate is tls.crt
+// of the CA Secret, the same certificate that kubeconfigs carry as certificate-authority-data.
+func (r *CertificateSetReconciler) reconcileCABundle(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	caSecret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: TargetNamespace(cs), Name: CASecretName(cs)}, caSecret); err != nil {
+		return fmt.Errorf("failed to get CA Secret: %w", err)
 	}
 
+	bundleSecret := buildCABundleSecret(cs, caSecret.Data["tls.crt"])
+	if err := r.setOwner(cs, bundleSecret); err != nil {
+		return fmt.Errorf("failed to set owner reference on CA bundle Secret: %w", err)
+	}
+
+	op, err := r.createOrUpdateSecret(ctx, bundleSecret, []string{"ca.crt"}, resyncRequested(cs))
+	if err != nil {
+		return fmt.Errorf("failed to create CA bundle Secret %s: %w", bundleSecret.Name, err)
+	}
+	r.recordSecretEvent(cs, bundleSecret, op)
+	r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeCABundle, bundleSecret.Namespace, bundleSecret.Name)
+	return nil
+}
+
+// reconcileCACertConfigMap publishes the CA certificate in a ConfigMap. It is the certificate of the
+// CA Secret (tls.crt), the same one the CA bundle Secret carries, so it follows CA rotation.
+func (r *CertificateSetReconciler) reconcileCACertConfigMap(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	caSecret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: TargetNamespace(cs), Name: CASecretName(cs)}, caSecret); err != nil {
+		return fmt.Errorf("failed to get CA Secret: %w", err)
+	}
+
+	cm := buildCACertConfigMap(cs, caSecret.Data["tls.crt"])
+	if err := r.setOwner(cs, cm); err != nil {
+		return fmt.Errorf("failed to set owner reference on CA certificate ConfigMap: %w", err)
+	}
+	if err := r.createOrUpdateConfigMap(ctx, cm, []string{caConfigMapKey(cs)}); err != nil {
+		return fmt.Errorf("failed to create CA certificate ConfigMap %s: %w", cm.Name, err)
+	}
+	return nil
+}
+
+// reconcileCAJKS publishes the CA certificate as a password-protected JKS truststore
+func (r *CertificateSetReconciler) reconcileCAJKS(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	caSecret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: TargetNamespace(cs), Name: CASecretName(cs)}, caSecret); err != nil {
+		return fmt.Errorf("failed to get CA Secret: %w", err)
+	}
+
+	ref := cs.Spec.JksPasswordSecretRef
+	if ref == nil {
+		return fmt.Errorf("%w: required when jksCABundle is enabled", ErrMissingJKSPasswordRef)
+	}
+	password, err := r.getSecretValue(ctx, TargetNamespace(cs), ref.Name, ref.Key)
+	if err != nil {
+		return fmt.Errorf("failed to read JKS password: %w", err)
+	}
+
+	truststore, err := encodeJKSTruststore(caSecret.Data["tls.crt"], password)
+	if err != nil {
+		return fmt.Errorf("failed to build JKS truststore: %w", err)
+	}
+
+	jksSecret := buildCAJKSSecret(cs, truststore)
+	if err := r.setOwner(cs, jksSecret); err != nil {
+		return fmt.Errorf("failed to set owner reference on JKS truststore Secret: %w", err)
+	}
+
+	op, err := r.createOrUpdateSecret(ctx, jksSecret, []string{"truststore.jks"}, resyncRequested(cs))
+	if err != nil {
+		return fmt.Errorf("failed to create JKS truststore Secret %s: %w", jksSecret.Name, err)
+	}
+	r.recordSecretEvent(cs, jksSecret, op)
+	r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeCAJKS, jksSecret.Namespace, jksSecret.Name)
+	return nil
+}
+
+// reconcileComponentCABundles publishes the etcd, Proxy and OIDC CA bundles and deletes the recorded ones that are
+// no longer wanted. A bundle whose source Secret is not issued yet is created on a later reconcile.
+func (r *CertificateSetReconciler) reconcileComponentCABundles(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	desired := make(map[string]bool, 3)
+	for _, bundle := range componentCABundles(cs) {
+		desired[bundle.name] = true
+
+		source := &corev1.Secret{}
+		if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: TargetNamespace(cs), Name: bundle.source}, source); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("failed to get Secret %s: %w", bundle.source, err)
+		}
+		caPEM := source.Data[bundle.key]
+		if len(caPEM) == 0 {
+			continue
+		}
+
+		bundleSecret := buildCABundleSecretWithName(cs, bundle.name, caPEM)
+		if err := r.setOwner(cs, bundleSecret); err != nil {
+			return fmt.Errorf("failed to set owner reference on CA bundle Secret %s: %w", bundle.name, err)
+		}
+		op, err := r.createOrUpdateSecret(ctx, bundleSecret, []string{"ca.crt"}, resyncRequested(cs))
+		if err != nil {
+			return fmt.Errorf("failed to create CA bundle Secret %s: %w", bundle.name, err)
+		}
+		r.recordSecretEvent(cs, bundleSecret, op)
+		r.setGeneratedSecret(cs, bundle.purpose, bundleSecret.Namespace, bundleSecret.Name)
+	}
+
+	for _, gs := range slices.Clone(cs.Status.GeneratedSecrets) {
+		switch gs.Purpose {
+		case incloudiov1alpha1.SecretPurposeETCDCABundle, incloudiov1alpha1.SecretPurposeProxyCABundle, incloudiov1alpha1.SecretPurposeCAOIDCBundle:
+		default:
+			continue
+		}
+		if desired[gs.Name] {
+			continue
+		}
+		if err := r.deleteOwnedIfExists(ctx, cs, types.NamespacedName{Namespace: gs.Namespace, Name: gs.Name}, &corev1.Secret{}); err != nil {
+			return fmt.Errorf("failed to delete CA bundle Secret %s: %w", gs.Name, err)
+		}
+		r.removeGeneratedSecret(cs, gs.Namespace, gs.Name)
+	}
+	return nil
+}
+
+// reconcileOIDCCABundle copies ca.crt of the OIDC Secret into the configured ConfigMap.
+// Nothing is written until cert-manager has populated ca.crt.
+func (r *CertificateSetReconciler) reconcileOIDCCABundle(ctx context.Context, cs *incloudiov1alpha1.CertificateSet) error {
+	secret := &corev1.Secret{}
+	if err := r.APIReader.Get(ctx, types.NamespacedName{Namespace: TargetNamespace(cs), Name: CAOIDCName(cs)}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get OIDC Secret: %w", err)
+	}
+	caPEM := secret.Data["ca.crt"]
+	if len(caPEM) == 0 {
+		return nil
+	}
+
+	cm := buildOIDCCABundleConfigMap(cs, caPEM)
+	if err := r.setOwner(cs, cm); err != nil {
+		return fmt.Errorf("failed to set owner reference on OIDC CA bundle ConfigMap: %w", err)
+	}
+	if err := r.createOrUpdateConfigMap(ctx, cm, []string{"ca.crt"}); err != nil {
+		return fmt.Errorf("failed to create OIDC CA bundle ConfigMap %s: %w", cm.Name, err)
+	}
 	return nil
 }
 
 // reconcileDerivedSecrets creates secrets derived from the super-admin certificate:
 // - kubeconfig Secret (if kubeconfig is enabled)
 // - ArgoCD cluster Secret (if argocdCluster is enabled)
-func (r *CertificateSetReconciler) reconcileDerivedSecrets(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, certData CertificateData) error {
+func (r *CertificateSetReconciler) reconcileDerivedSecrets(ctx context.Context, cs *incloudiov1alpha1.CertificateSet, certData CertificateData) (err error) {
+	ctx, span := tracer.Start(ctx, "reconcileDerivedSecrets")
+	defer func() { endSpan(span, err) }()
+
 	log := logf.FromContext(ctx)
 	log.Info("Creating derived secrets")
 
+	// Rendering with an empty server would produce a Secret that silently fails to connect
+	if (cs.Spec.Kubeconfig || cs.Spec.ArgocdCluster) && cs.Spec.KubeconfigEndpoint == "" {
+		return ErrMissingEndpoint
+	}
+
 	// Create kubeconfig Secret
 	if cs.Spec.Kubeconfig {
-		kubeconfigSecret, err := buildKubeconfigSecret(cs, certData)
+		var token string
+		if usesTokenAuth(cs) && cs.Spec.TokenSecretRef != nil {
+			var err error
+			token, err = r.getSecretValue(ctx, TargetNamespace(cs), cs.Spec.TokenSecretRef.Name, cs.Spec.TokenSecretRef.Key)
+			if err != nil {
+				return fmt.Errorf("failed to read kubeconfig token: %w", err)
+			}
+		}
+
+		tmpl, err := r.loadKubeconfigTemplate(ctx, cs)
+		if err != nil {
+			return err
+		}
+
+		kubeconfigSecret, err := buildKubeconfigSecret(cs, certData, token, tmpl)
 		if err != nil {
 			return fmt.Errorf("failed to build kubeconfig Secret: %w", err)
 		}
-		if err := controllerutil.SetControllerReference(cs, kubeconfigSecret, r.Scheme); err != nil {
+		if err := r.setOwner(cs, kubeconfigSecret); err != nil {
 			return fmt.Errorf("failed to set owner reference on kubeconfig Secret: %w", err)
 		}
 
-		if err := r.createOrUpdateSecret(ctx, kubeconfigSecret, []string{"value"}); err != nil {
-			return fmt.Errorf("failed to create kubeconfig Secret: %w", err)
+		op, err := r.createOrUpdateSecret(ctx, kubeconfigSecret, []string{kubeconfigSecretKey(cs)}, resyncRequested(cs))
+		if err != nil {
+			return fmt.Errorf("failed to create kubeconfig Secret %s: %w", kubeconfigSecret.Name, err)
 		}
+		r.recordSecretEvent(cs, kubeconfigSecret, op)
+		r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeKubeconfig, kubeconfigSecret.Namespace, kubeconfigSecret.Name)
+
+		if err := r.reconcileKubeconfigMirrors(ctx, cs, kubeconfigSecret); err != nil {
+			return err
+		}
+
+		// Opt-in only: status exposes the credentials to anyone allowed to read the CertificateSet
+		if cs.Spec.PublishKubeconfigInStatus {
+			cs.Status.Kubeconfig = kubeconfigSecret.Data[kubeconfigSecretKey(cs)]
+		}
+	}
+	if !cs.Spec.PublishKubeconfigInStatus {
+		cs.Status.Kubeconfig = nil
 	}
 
-	// Create ArgoCD cluster Secret
-	if cs.Spec.ArgocdCluster {
-		// Check if ArgoCD namespace exists
-		argocdNs := &corev1.Namespace{}
-		if err := r.APIReader.Get(ctx, types.NamespacedName{Name: ArgoCDNamespace}, argocdNs); err != nil {
-			if apierrors.IsNotFound(err) {
-				return fmt.Errorf("ArgoCD namespace %q does not exist", ArgoCDNamespace)
-			}
-			return fmt.Errorf("failed to check ArgoCD namespace: %w", err)
+	// Create full chain Secret
+	if cs.Spec.FullChainSecret {
+		fullChainSecret, err := buildFullChainSecret(cs, certData)
+		if err != nil {
+			return fmt.Errorf("failed to build full chain Secret: %w", err)
+		}
+		if err := r.setOwner(cs, fullChainSecret); err != nil {
+			return fmt.Errorf("failed to set owner reference on full chain Secret: %w", err)
 		}
+		op, err := r.createOrUpdateSecret(ctx, fullChainSecret, []string{fullChainKey}, resyncRequested(cs))
+		if err != nil {
+			return fmt.Errorf("failed to create full chain Secret %s: %w", fullChainSecret.Name, err)
+		}
+		r.recordSecretEvent(cs, fullChainSecret, op)
+		r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeFullChain, fullChainSecret.Namespace, fullChainSecret.Name)
+	}
 
-		argocdSecret, err := buildArgoCDClusterSecret(cs, certData)
+	// Create cluster-info ConfigMap
+	if generateClusterInfo(cs) {
+		cm, err := buildClusterInfoConfigMap(cs, certData)
 		if err != nil {
-			return fmt.Errorf("failed to build ArgoCD cluster Secret: %w", err)
+			return fmt.Errorf("failed to build cluster-info ConfigMap: %w", err)
+		}
+		if err := r.setOwner(cs, cm); err != nil {
+			return fmt.Errorf("failed to set owner reference on cluster-info ConfigMap: %w", err)
 		}
-		if err := r.createOrUpdateSecret(ctx, argocdSecret, []string{"config", "name", "server"}); err != nil {
-			return fmt.Errorf("failed to create ArgoCD cluster Secret: %w", err)
+		if err := r.createOrUpdateConfigMap(ctx, cm, []string{clusterInfoKey}); err != nil {
+			return fmt.Errorf("failed to create cluster-info ConfigMap %s: %w", cm.Name, err)
+		}
+	}
+
+	// Create ArgoCD cluster Secrets, one per ArgoCD instance
+	if cs.Spec.ArgocdCluster {
+		keep := ArgoCDClusterSecrets(cs)
+		for _, key := range keep {
+			// Check if ArgoCD namespace exists
+			argocdNs := &corev1.Namespace{}
+			if err := r.APIReader.Get(ctx, types.NamespacedName{Name: key.Namespace}, argocdNs); err != nil {
+				if apierrors.IsNotFound(err) {
+					return fmt.Errorf("%w: %q does not exist", ErrArgoCDNamespaceNotFound, key.Namespace)
+				}
+				return fmt.Errorf("failed to check ArgoCD namespace: %w", err)
+			}
+			// Secrets cannot be created in a namespace that is being deleted
+			if argocdNs.Status.Phase == corev1.NamespaceTerminating {
+				return fmt.Errorf("%w: %q", ErrArgoCDNamespaceTerminating, key.Namespace)
+			}
+
+			argocdSecret, err := buildArgoCDClusterSecret(cs, certData, key)
+			if err != nil {
+				return fmt.Errorf("failed to build ArgoCD cluster Secret: %w", err)
+			}
+			if err := r.checkArgoCDServerUnique(ctx, cs, argocdSecret); err != nil {
+				return err
+			}
+			op, err := r.createOrUpdateSecret(ctx, argocdSecret, argoCDClusterSecretKeys(cs), resyncRequested(cs))
+			if err != nil {
+				return fmt.Errorf("failed to create ArgoCD cluster Secret %s/%s: %w", argocdSecret.Namespace, argocdSecret.Name, err)
+			}
+			r.recordSecretEvent(cs, argocdSecret, op)
+			r.setGeneratedSecret(cs, incloudiov1alpha1.SecretPurposeArgoCDCluster, argocdSecret.Namespace, argocdSecret.Name)
+		}
+
+		// Remove Secrets left for previously configured ArgoCD namespaces or targets
+		if err := r.cleanupArgoCDClusterSecrets(ctx, cs, keep); err != nil {
+			return fmt.Errorf("failed to clean up stale ArgoCD cluster Secrets: %w", err)
 		}
 	}
 
@@ -0,0 +1,525 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+var _ = Describe("reconcileCACertificates", func() {
+	It("creates every CA Certificate even when one of them fails", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentSystem,
+				IssuerRef:   incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+			},
+		}
+
+		root := &certmanagerv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: "root"}}
+
+		r, fakeClient := newTestReconcilerWithInterceptor(interceptor.Funcs{
+			Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+				if obj.GetName() == ETCDName(cs) {
+					return fmt.Errorf("injected failure")
+				}
+				return c.Create(ctx, obj, opts...)
+			},
+		}, root)
+
+		err := r.reconcileCACertificates(ctx, cs)
+		Expect(err).To(MatchError(ContainSubstring("failed to create ETCD Certificate")))
+
+		for _, name := range []string{CAName(cs), ProxyName(cs), CAOIDCName(cs)} {
+			cert := &certmanagerv1.Certificate{}
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: cs.Namespace, Name: name}, cert)).To(Succeed())
+		}
+
+		Expect(cs.Status.GeneratedSecrets).To(HaveLen(3))
+		Expect(cs.Status.GeneratedSecrets).NotTo(ContainElement(HaveField("Name", ETCDName(cs))))
+	})
+
+	It("self-signs the client CA through its own Issuer without spec.issuerRef", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:  incloudiov1alpha1.EnvironmentClient,
+				Kubeconfig:   true,
+				SelfSignedCA: true,
+			},
+		}
+
+		r, fakeClient := newTestReconciler()
+
+		Expect(r.reconcileCACertificates(ctx, cs)).To(Succeed())
+
+		issuer := &certmanagerv1.Issuer{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: SelfSignedIssuerName(cs)}, issuer)).To(Succeed())
+		Expect(issuer.Spec.SelfSigned).NotTo(BeNil())
+
+		ca := &certmanagerv1.Certificate{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: CAName(cs)}, ca)).To(Succeed())
+		Expect(ca.Spec.IsCA).To(BeTrue())
+		Expect(ca.Spec.IssuerRef.Kind).To(Equal("Issuer"))
+		Expect(ca.Spec.IssuerRef.Name).To(Equal("demo-selfsigned"))
+		Expect(AllCertificateNames(cs)).To(ConsistOf(CAName(cs), SuperAdminName(cs)))
+	})
+})
+
+var _ = Describe("Additional client certificates", func() {
+	It("issues a Certificate and kubeconfig per client and removes them once the client is dropped", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:        incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:          incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				KubeconfigEndpoint: "https://api.example.com:6443",
+				ClientCertificates: []incloudiov1alpha1.ClientCertSpec{{Name: "ci"}, {Name: "ops"}},
+			},
+		}
+		r, fakeClient := newTestReconciler()
+
+		Expect(r.reconcileClientCertificates(ctx, cs)).To(Succeed())
+		for _, name := range []string{"demo-ci", "demo-ops"} {
+			cert := &certmanagerv1.Certificate{}
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: name}, cert)).To(Succeed())
+			Expect(cert.Spec.IssuerRef.Name).To(Equal(CAName(cs)))
+		}
+
+		// cert-manager issues only the ci certificate; ops gets its kubeconfig on a later pass
+		issued := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "demo-ci",
+				Namespace:   "default",
+				Annotations: map[string]string{certmanagerv1.CertificateNameKey: "demo-ci"},
+			},
+			Data: map[string][]byte{"ca.crt": []byte("ca"), "tls.crt": []byte("crt"), "tls.key": []byte("key")},
+		}
+		Expect(fakeClient.Create(ctx, issued)).To(Succeed())
+
+		Expect(r.reconcileClientKubeconfigs(ctx, cs)).To(Succeed())
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: ClientKubeconfigName(cs, "ci")}, &corev1.Secret{})).To(Succeed())
+		Expect(apierrors.IsNotFound(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: ClientKubeconfigName(cs, "ops")}, &corev1.Secret{}))).To(BeTrue())
+		Expect(cs.Status.GeneratedSecrets).To(ConsistOf(
+			incloudiov1alpha1.GeneratedSecret{Name: "demo-ci", Namespace: "default", Purpose: incloudiov1alpha1.SecretPurposeClientCertificate},
+			incloudiov1alpha1.GeneratedSecret{Name: "demo-ops", Namespace: "default", Purpose: incloudiov1alpha1.SecretPurposeClientCertificate},
+			incloudiov1alpha1.GeneratedSecret{Name: "demo-ci-kubeconfig", Namespace: "default", Purpose: incloudiov1alpha1.SecretPurposeClientKubeconfig},
+		))
+
+		cs.Spec.ClientCertificates = []incloudiov1alpha1.ClientCertSpec{{Name: "ops"}}
+		Expect(r.cleanupStaleClientCertificates(ctx, cs)).To(Succeed())
+
+		for _, obj := range []client.Object{
+			&certmanagerv1.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "demo-ci", Namespace: "default"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "demo-ci", Namespace: "default"}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "demo-ci-kubeconfig", Namespace: "default"}},
+		} {
+			Expect(apierrors.IsNotFound(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj))).To(BeTrue(), "%T %s should be deleted", obj, obj.GetName())
+		}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "demo-ops"}, &certmanagerv1.Certificate{})).To(Succeed())
+		Expect(cs.Status.GeneratedSecrets).To(ConsistOf(
+			incloudiov1alpha1.GeneratedSecret{Name: "demo-ops", Namespace: "default", Purpose: incloudiov1alpha1.SecretPurposeClientCertificate},
+		))
+	})
+})
+
+var _ = Describe("Reconcile timeout", func() {
+	It("returns a retryable error when an API call outlives the reconcile deadline", func() {
+		r, _ := newTestReconcilerWithInterceptor(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		})
+		r.ReconcileTimeout = 10 * time.Millisecond
+
+		_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "demo"}})
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(err).To(MatchError(ContainSubstring("reconcile timed out after 10ms")))
+	})
+})
+
+var _ = Describe("Secret wait backoff", func() {
+	It("keeps growing while the super-admin Secret is missing after the CA is ready", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:        incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:          incloudiov1alpha1.IssuerReference{APIVersion: "cert-manager.io/v1", Kind: "ClusterIssuer", Name: "root"},
+				Kubeconfig:         true,
+				KubeconfigEndpoint: "https://api.example.com:6443",
+			},
+		}
+		root := &certmanagerv1.ClusterIssuer{ObjectMeta: metav1.ObjectMeta{Name: "root"}}
+		ca := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: CASecretName(cs), Namespace: "default"},
+			Data:       map[string][]byte{"ca.crt": []byte("root"), "tls.crt": []byte("ca"), "tls.key": []byte("key")},
+		}
+
+		r, fakeClient := newTestReconciler(cs, root, ca)
+		req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cs)}
+
+		// The first pass adds the finalizer
+		_, err := r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		first, err := r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fakeClient.Get(ctx, req.NamespacedName, cs)).To(Succeed())
+		Expect(meta.FindStatusCondition(cs.Status.Conditions, ConditionTypeProgressing).Reason).To(Equal("WaitingForSuperAdminSecret"))
+
+		second, err := r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(first.RequeueAfter).To(BeNumerically(">", 0))
+		Expect(second.RequeueAfter).To(BeNumerically(">", first.RequeueAfter))
+	})
+})
+
+var _ = Describe("Paused annotation", func() {
+	It("creates nothing and reports the Paused condition", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "demo",
+				Namespace:   "default",
+				Annotations: map[string]string{PausedAnnotation: "true"},
+			},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:   incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+			},
+		}
+
+		r, fakeClient := newTestReconciler(cs)
+
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cs)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(cs), cs)).To(Succeed())
+		Expect(cs.Finalizers).To(BeEmpty())
+		Expect(meta.IsStatusConditionTrue(cs.Status.Conditions, ConditionTypePaused)).To(BeTrue())
+
+		certs := &certmanagerv1.CertificateList{}
+		Expect(fakeClient.List(ctx, certs)).To(Succeed())
+		Expect(certs.Items).To(BeEmpty())
+	})
+})
+
+var _ = Describe("reconcileDerivedSecrets", func() {
+	It("refuses to write the ArgoCD Secret into a terminating namespace", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:        incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:          incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				ArgocdCluster:      true,
+				ArgoCDNamespace:    "argocd",
+				KubeconfigEndpoint: "https://api.example.com:6443",
+			},
+		}
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd"},
+			Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceTerminating},
+		}
+
+		r, fakeClient := newTestReconciler(ns)
+
+		err := r.reconcileDerivedSecrets(ctx, cs, CertificateData{CACert: "Y2E=", TLSCert: "Y2VydA==", TLSKey: "a2V5"})
+		Expect(err).To(MatchError(ErrArgoCDNamespaceTerminating))
+		Expect(reasonForError(err, "DerivedSecretsFailed")).To(Equal("ArgoCDNamespaceTerminating"))
+
+		secrets := &corev1.SecretList{}
+		Expect(fakeClient.List(ctx, secrets, client.InNamespace("argocd"))).To(Succeed())
+		Expect(secrets.Items).To(BeEmpty())
+	})
+
+	It("refuses to register a server that another ArgoCD cluster Secret already uses", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:        incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:          incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				ArgocdCluster:      true,
+				ArgoCDNamespace:    "argocd",
+				KubeconfigEndpoint: "https://api.example.com:6443",
+			},
+		}
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "argocd"}}
+		other := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "other-argocd-cluster",
+				Namespace: "argocd",
+				Labels:    map[string]string{"argocd.argoproj.io/secret-type": "cluster"},
+			},
+			Data: map[string][]byte{"server": []byte("https://api.example.com:6443")},
+		}
+
+		r, fakeClient := newTestReconciler(ns, other)
+
+		certData := CertificateData{CACert: "Y2E=", TLSCert: "Y2VydA==", TLSKey: "a2V5"}
+		err := r.reconcileDerivedSecrets(ctx, cs, certData)
+		Expect(err).To(MatchError(ErrDuplicateArgoCDServer))
+		Expect(reasonForError(err, "DerivedSecretsFailed")).To(Equal("DuplicateArgoCDServer"))
+		Expect(apierrors.IsNotFound(fakeClient.Get(ctx, ArgoCDClusterSecrets(cs)[0], &corev1.Secret{}))).To(BeTrue())
+
+		// The check only guards creation: the Secret this CertificateSet already owns keeps being updated
+		owned, err := buildArgoCDClusterSecret(cs, certData, ArgoCDClusterSecrets(cs)[0])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.setOwner(cs, owned)).To(Succeed())
+		Expect(fakeClient.Create(ctx, owned)).To(Succeed())
+		Expect(r.reconcileDerivedSecrets(ctx, cs, certData)).To(Succeed())
+	})
+
+	It("replaces its own ArgoCD cluster Secret when the target name prefix changes", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:        incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:          incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				ArgocdCluster:      true,
+				ArgoCDTargets:      []incloudiov1alpha1.ArgoCDTarget{{Namespace: "argocd", NamePrefix: "old-"}},
+				KubeconfigEndpoint: "https://api.example.com:6443",
+			},
+		}
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "argocd"}}
+
+		r, fakeClient := newTestReconciler(ns)
+
+		certData := CertificateData{CACert: "Y2E=", TLSCert: "Y2VydA==", TLSKey: "a2V5"}
+		Expect(r.reconcileDerivedSecrets(ctx, cs, certData)).To(Succeed())
+		oldKey := ArgoCDClusterSecrets(cs)[0]
+		Expect(fakeClient.Get(ctx, oldKey, &corev1.Secret{})).To(Succeed())
+
+		cs.Spec.ArgoCDTargets[0].NamePrefix = "new-"
+		Expect(r.reconcileDerivedSecrets(ctx, cs, certData)).To(Succeed())
+		Expect(fakeClient.Get(ctx, ArgoCDClusterSecrets(cs)[0], &corev1.Secret{})).To(Succeed())
+		Expect(apierrors.IsNotFound(fakeClient.Get(ctx, oldKey, &corev1.Secret{}))).To(BeTrue())
+	})
+
+	It("mirrors the kubeconfig Secret and removes copies from dropped namespaces", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:                incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:                  incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				Kubeconfig:                 true,
+				KubeconfigEndpoint:         "https://api.example.com:6443",
+				KubeconfigMirrorNamespaces: []string{"team-a", "team-b", "default"},
+			},
+		}
+		teamA := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+		teamB := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}}
+
+		r, fakeClient := newTestReconciler(teamA, teamB)
+
+		certData := CertificateData{CACert: "Y2E=", TLSCert: "Y2VydA==", TLSKey: "a2V5"}
+		Expect(r.reconcileDerivedSecrets(ctx, cs, certData)).To(Succeed())
+
+		source := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: KubeconfigName(cs)}, source)).To(Succeed())
+		Expect(KubeconfigMirrorSecrets(cs)).To(HaveLen(2))
+		for _, key := range KubeconfigMirrorSecrets(cs) {
+			mirror := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, key, mirror)).To(Succeed())
+			Expect(mirror.Data).To(Equal(source.Data))
+			Expect(isOwnedBy(cs, mirror)).To(BeTrue())
+		}
+
+		cs.Spec.KubeconfigMirrorNamespaces = []string{"team-a"}
+		Expect(r.reconcileDerivedSecrets(ctx, cs, certData)).To(Succeed())
+		Expect(apierrors.IsNotFound(fakeClient.Get(ctx, types.NamespacedName{Namespace: "team-b", Name: KubeconfigName(cs)}, &corev1.Secret{}))).To(BeTrue())
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "team-a", Name: KubeconfigName(cs)}, &corev1.Secret{})).To(Succeed())
+		Expect(cs.Status.GeneratedSecrets).NotTo(ContainElement(incloudiov1alpha1.GeneratedSecret{
+			Name: KubeconfigName(cs), Namespace: "team-b", Purpose: incloudiov1alpha1.SecretPurposeKubeconfigMirror,
+		}))
+	})
+})
+
+var _ = Describe("Deletion", func() {
+	It("keeps the finalizer until the ArgoCD cluster Secret is gone", func() {
+		ctx := context.Background()
+
+		now := metav1.Now()
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "demo",
+				Namespace:         "default",
+				Finalizers:        []string{DefaultFinalizerName},
+				DeletionTimestamp: &now,
+			},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:     incloudiov1alpha1.EnvironmentClient,
+				IssuerRef:       incloudiov1alpha1.IssuerReference{Kind: "ClusterIssuer", Name: "root"},
+				ArgocdCluster:   true,
+				ArgoCDNamespace: "argocd",
+			},
+		}
+		argocdSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "demo-argocd-cluster", Namespace: "argocd"}}
+
+		// The first delete is swallowed, as if the Secret was recreated right after being deleted
+		swallowDelete := true
+		r, fakeClient := newTestReconcilerWithInterceptor(interceptor.Funcs{
+			Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+				if swallowDelete && obj.GetName() == argocdSecret.Name {
+					swallowDelete = false
+					return nil
+				}
+				return c.Delete(ctx, obj, opts...)
+			},
+		}, cs, argocdSecret)
+		req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(cs)}
+
+		result, err := r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		Expect(fakeClient.Get(ctx, req.NamespacedName, cs)).To(Succeed())
+		Expect(cs.Finalizers).To(ContainElement(DefaultFinalizerName))
+		Expect(cs.Status.Phase).To(Equal(incloudiov1alpha1.PhaseDeleting))
+		progressing := meta.FindStatusCondition(cs.Status.Conditions, ConditionTypeProgressing)
+		Expect(progressing).NotTo(BeNil())
+		Expect(progressing.Reason).To(Equal("Deleting"))
+		Expect(progressing.Message).To(ContainSubstring("argocd/demo-argocd-cluster"))
+
+		result, err = r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(argocdSecret), &corev1.Secret{})).NotTo(Succeed())
+	})
+})
+
+var _ = Describe("reconcileComponentCABundles", func() {
+	It("publishes issued CA bundles and removes them once disabled", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:               incloudiov1alpha1.EnvironmentSystem,
+				PublishComponentCABundles: true,
+			},
+		}
+		etcd := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-etcd", Namespace: "default"},
+			Data:       map[string][]byte{"ca.crt": []byte("root"), "tls.crt": []byte("etcd-ca"), "tls.key": []byte("key")},
+		}
+
+		r, fakeClient := newTestReconciler(etcd)
+
+		// The Proxy and OIDC Secrets are not issued yet
+		Expect(r.reconcileComponentCABundles(ctx, cs)).To(Succeed())
+		bundle := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "demo-etcd-ca-bundle"}, bundle)).To(Succeed())
+		Expect(bundle.Data).To(Equal(map[string][]byte{"ca.crt": []byte("etcd-ca")}))
+		Expect(cs.Status.GeneratedSecrets).To(ConsistOf(incloudiov1alpha1.GeneratedSecret{
+			Name: "demo-etcd-ca-bundle", Namespace: "default", Purpose: incloudiov1alpha1.SecretPurposeETCDCABundle,
+		}))
+
+		cs.Spec.PublishComponentCABundles = false
+		Expect(r.reconcileComponentCABundles(ctx, cs)).To(Succeed())
+		err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "demo-etcd-ca-bundle"}, bundle)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(cs.Status.GeneratedSecrets).To(BeEmpty())
+	})
+})
+
+var _ = Describe("reconcileCACertConfigMap", func() {
+	It("publishes the CA certificate, follows CA rotation and is removed once disabled", func() {
+		ctx := context.Background()
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default", UID: "demo-uid"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:        incloudiov1alpha1.EnvironmentClient,
+				PublishCAConfigMap: true,
+				CAConfigMapKey:     "caBundle",
+			},
+		}
+		ca := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-ca", Namespace: "default"},
+			Data:       map[string][]byte{"ca.crt": []byte("root"), "tls.crt": []byte("ca-v1"), "tls.key": []byte("key")},
+		}
+
+		r, fakeClient := newTestReconciler(ca)
+		key := types.NamespacedName{Namespace: "default", Name: "demo-ca-cert"}
+
+		Expect(r.reconcileCACertConfigMap(ctx, cs)).To(Succeed())
+		cm := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, key, cm)).To(Succeed())
+		Expect(cm.Data).To(Equal(map[string]string{"caBundle": "ca-v1"}))
+		Expect(metav1.IsControlledBy(cm, cs)).To(BeTrue())
+
+		ca.Data["tls.crt"] = []byte("ca-v2")
+		Expect(fakeClient.Update(ctx, ca)).To(Succeed())
+		Expect(r.reconcileCACertConfigMap(ctx, cs)).To(Succeed())
+		Expect(fakeClient.Get(ctx, key, cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue("caBundle", "ca-v2"))
+
+		cs.Spec.PublishCAConfigMap = false
+		Expect(r.cleanupOrphanedResources(ctx, cs)).To(Succeed())
+		Expect(apierrors.IsNotFound(fakeClient.Get(ctx, key, cm))).To(BeTrue())
+	})
+})
+
+var _ = Describe("reconcileCAJKS", func() {
+	It("reports a missing password reference with its own reason", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec:       incloudiov1alpha1.CertificateSetSpec{Environment: incloudiov1alpha1.EnvironmentClient, JksCABundle: true},
+		}
+		ca := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: CASecretName(cs), Namespace: "default"}}
+		r, _ := newTestReconciler(ca)
+
+		err := r.reconcileCAJKS(context.Background(), cs)
+		Expect(err).To(MatchError(ErrMissingJKSPasswordRef))
+		Expect(reasonForError(err, "DerivedSecretsFailed")).To(Equal("MissingJKSPasswordRef"))
+	})
+})
@@ -0,0 +1,112 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import "errors"
+
+// Reconcile failures wrap one of these errors, so callers can tell them apart with errors.Is
+var (
+	// ErrCertManagerMissing is returned when the cert-manager.io/v1 Certificate kind is not served by the API server
+	ErrCertManagerMissing = errors.New("cert-manager is not installed")
+
+	// ErrIssuerNotFound is returned when spec.issuerRef points to a missing Issuer or ClusterIssuer
+	ErrIssuerNotFound = errors.New("issuer not found")
+
+	// ErrResourceConflict is returned when a Certificate or Issuer with the expected name exists
+	// but is not owned by this CertificateSet
+	ErrResourceConflict = errors.New("resource conflict")
+
+	// ErrInvalidLabels is returned when labels propagated to child resources are not valid Kubernetes labels
+	ErrInvalidLabels = errors.New("invalid labels")
+
+	// ErrMissingEndpoint is returned when a kubeconfig or ArgoCD secret is requested without spec.kubeconfigEndpoint
+	ErrMissingEndpoint = errors.New("spec.kubeconfigEndpoint is empty")
+
+	// ErrInvalidEndpoint is returned when spec.kubeconfigEndpoint is not a usable http(s) URL
+	ErrInvalidEndpoint = errors.New("invalid kubeconfigEndpoint")
+
+	// ErrTemplateRender is returned when a kubeconfig, cluster-info or ArgoCD template fails to parse or render
+	ErrTemplateRender = errors.New("template render failed")
+
+	// ErrTemplateRefNotReady is returned when the ConfigMap from spec.kubeconfigTemplateRef is missing
+	// or has no value for the key
+	ErrTemplateRefNotReady = errors.New("referenced kubeconfig template is not ready")
+
+	// ErrSecretRefNotReady is returned when a Secret referenced from the spec (token, PKCS#12 or JKS password)
+	// is missing or has no value for the key
+	ErrSecretRefNotReady = errors.New("referenced Secret is not ready")
+
+	// ErrInvalidLiteralSubject is returned when spec.literalSubject is not an RFC 4514 distinguished name
+	// that cert-manager can encode
+	ErrInvalidLiteralSubject = errors.New("invalid literalSubject")
+
+	// ErrArgoCDNamespaceNotFound is returned when the namespace of an ArgoCD target does not exist
+	ErrArgoCDNamespaceNotFound = errors.New("ArgoCD namespace not found")
+
+	// ErrArgoCDNamespaceTerminating is returned when the namespace of an ArgoCD target is being deleted
+	ErrArgoCDNamespaceTerminating = errors.New("ArgoCD namespace is terminating")
+
+	// ErrDuplicateArgoCDServer is returned when another ArgoCD cluster Secret already registers the same server
+	ErrDuplicateArgoCDServer = errors.New("duplicate ArgoCD cluster server")
+
+	// ErrKubeconfigMirrorNamespaceNotFound is returned when a spec.kubeconfigMirrorNamespaces namespace does not exist
+	ErrKubeconfigMirrorNamespaceNotFound = errors.New("kubeconfig mirror namespace not found")
+
+	// ErrMissingJKSPasswordRef is returned when a JKS truststore is requested without spec.jksPasswordSecretRef
+	ErrMissingJKSPasswordRef = errors.New("spec.jksPasswordSecretRef is empty")
+
+	// ErrSecretTypeImmutable is returned when an existing derived Secret has a different type than desired
+	ErrSecretTypeImmutable = errors.New("secret type is immutable")
+
+	// ErrClusterIssuerNamespace is returned when issuerScope is ClusterIssuer but the CA Secret is outside
+	// the cert-manager cluster resource namespace, where the ClusterIssuer would never find it
+	ErrClusterIssuerNamespace = errors.New("CA Secret is outside the cert-manager cluster resource namespace")
+)
+
+// errorReasons maps each typed error to its condition and event reason
+var errorReasons = []struct {
+	err    error
+	reason string
+}{
+	{ErrCertManagerMissing, "CertManagerMissing"},
+	{ErrIssuerNotFound, "IssuerNotFound"},
+	{ErrResourceConflict, "ResourceConflict"},
+	{ErrInvalidLabels, "InvalidLabels"},
+	{ErrMissingEndpoint, "MissingEndpoint"},
+	{ErrInvalidEndpoint, "InvalidEndpoint"},
+	{ErrTemplateRender, "TemplateRenderFailed"},
+	{ErrTemplateRefNotReady, "TemplateRefNotReady"},
+	{ErrSecretRefNotReady, "SecretRefNotReady"},
+	{ErrInvalidLiteralSubject, "InvalidLiteralSubject"},
+	{ErrArgoCDNamespaceNotFound, "ArgoCDNamespaceNotFound"},
+	{ErrArgoCDNamespaceTerminating, "ArgoCDNamespaceTerminating"},
+	{ErrDuplicateArgoCDServer, "DuplicateArgoCDServer"},
+	{ErrKubeconfigMirrorNamespaceNotFound, "KubeconfigMirrorNamespaceNotFound"},
+	{ErrMissingJKSPasswordRef, "MissingJKSPasswordRef"},
+	{ErrSecretTypeImmutable, "SecretTypeImmutable"},
+	{ErrClusterIssuerNamespace, "ClusterIssuerNamespaceMismatch"},
+}
+
+// reasonForError returns the condition reason of the typed error wrapped by err, or fallback for other errors
+func reasonForError(err error, fallback string) string {
+	for _, e := range errorReasons {
+		if errors.Is(err, e.err) {
+			return e.reason
+		}
+	}
+	return fallback
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// ControllerName is the name of the CertificateSet controller and of its workqueue
+const ControllerName = "certificateset"
+
+// workqueueDepthMetric is the controller-runtime gauge with the current depth of each controller workqueue
+const workqueueDepthMetric = metrics.WorkQueueSubsystem + "_" + metrics.DepthKey
+
+// BacklogChecker is a readiness check that fails once the controller workqueue has stayed deeper
+// than Threshold for Window. Only the leader runs the controller, so other replicas stay ready.
+type BacklogChecker struct {
+	Threshold int
+	Window    time.Duration
+	// Gatherer defaults to the controller-runtime metrics registry
+	Gatherer prometheus.Gatherer
+
+	mu    sync.Mutex
+	since time.Time
+}
+
+// Check implements healthz.Checker
+func (c *BacklogChecker) Check(_ *http.Request) error {
+	depth, err := c.queueDepth()
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if depth <= float64(c.Threshold) {
+		c.since = time.Time{}
+		return nil
+	}
+	if c.since.IsZero() {
+		c.since = time.Now()
+	}
+	if stuck := time.Since(c.since); stuck >= c.Window {
+		return fmt.Errorf("workqueue %s depth %.0f above %d for %s", ControllerName, depth, c.Threshold, stuck.Round(time.Second))
+	}
+	return nil
+}
+
+// queueDepth sums the depth series of the controller workqueue over all priorities
+func (c *BacklogChecker) queueDepth() (float64, error) {
+	gatherer := c.Gatherer
+	if gatherer == nil {
+		gatherer = metrics.Registry
+	}
+	families, err := gatherer.Gather()
+	if err != nil {
+		return 0, fmt.Errorf("failed to gather metrics: %w", err)
+	}
+
+	var depth float64
+	for _, family := range families {
+		if family.GetName() != workqueueDepthMetric {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "name" && label.GetValue() == ControllerName {
+					depth += m.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return depth, nil
+}
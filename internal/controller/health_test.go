@@ -0,0 +1,85 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// brokenCollector reports an invalid metric so that gathering fails
+type brokenCollector struct {
+	desc *prometheus.Desc
+}
+
+func (c brokenCollector) Describe(ch chan<- *prometheus.Desc) { ch <- c.desc }
+
+func (c brokenCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.NewInvalidMetric(c.desc, errors.New("boom"))
+}
+
+var _ = Describe("BacklogChecker", func() {
+	var (
+		registry *prometheus.Registry
+		depth    *prometheus.GaugeVec
+	)
+
+	BeforeEach(func() {
+		registry = prometheus.NewRegistry()
+		depth = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: workqueueDepthMetric}, []string{"name", "priority"})
+		registry.MustRegister(depth)
+	})
+
+	It("stays ready while the queue is within the threshold", func() {
+		depth.WithLabelValues(ControllerName, "0").Set(3)
+		depth.WithLabelValues("other", "0").Set(100)
+		checker := &BacklogChecker{Threshold: 5, Gatherer: registry}
+
+		Expect(checker.Check(nil)).To(Succeed())
+	})
+
+	It("fails once the backlog outlasts the window and recovers when it drains", func() {
+		depth.WithLabelValues(ControllerName, "0").Set(4)
+		depth.WithLabelValues(ControllerName, "10").Set(4)
+		checker := &BacklogChecker{Threshold: 5, Gatherer: registry}
+
+		Expect(checker.Check(nil)).To(MatchError(ContainSubstring("depth 8 above 5")))
+
+		depth.WithLabelValues(ControllerName, "10").Set(0)
+		Expect(checker.Check(nil)).To(Succeed())
+	})
+
+	It("stays ready while the backlog is younger than the window", func() {
+		depth.WithLabelValues(ControllerName, "0").Set(10)
+		checker := &BacklogChecker{Threshold: 5, Window: time.Hour, Gatherer: registry}
+
+		Expect(checker.Check(nil)).To(Succeed())
+		Expect(checker.Check(nil)).To(Succeed())
+	})
+
+	It("fails when the metrics cannot be gathered", func() {
+		desc := prometheus.NewDesc("broken", "", nil, nil)
+		registry.MustRegister(brokenCollector{desc: desc})
+		checker := &BacklogChecker{Gatherer: registry}
+
+		Expect(checker.Check(nil)).To(MatchError(ContainSubstring("failed to gather metrics")))
+	})
+})
@@ -0,0 +1,157 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"time"
+	"unicode/utf16"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// jksEntry is a trusted certificate entry read back from a JKS truststore
+type jksEntry struct {
+	alias     string
+	timestamp int64
+	certType  string
+	der       []byte
+}
+
+// decodeJKSTruststore reads a JKS store of trusted certificate entries and verifies its keyed SHA-1
+// integrity digest against password
+func decodeJKSTruststore(data []byte, password string) []jksEntry {
+	GinkgoHelper()
+
+	Expect(len(data)).To(BeNumerically(">", sha1.Size))
+	body, digest := data[:len(data)-sha1.Size], data[len(data)-sha1.Size:]
+
+	want := sha1.New()
+	for _, c := range utf16.Encode([]rune(password)) {
+		want.Write([]byte{byte(c >> 8), byte(c)})
+	}
+	want.Write([]byte(jksDigestWhitener))
+	want.Write(body)
+	Expect(digest).To(Equal(want.Sum(nil)), "integrity digest")
+
+	r := bytes.NewReader(body)
+	read := func(v any) { Expect(binary.Read(r, binary.BigEndian, v)).To(Succeed()) }
+	readUTF := func() string {
+		var n uint16
+		read(&n)
+		s := make([]byte, n)
+		read(s)
+		return string(s)
+	}
+
+	var magic, version, count uint32
+	read(&magic)
+	read(&version)
+	read(&count)
+	Expect(magic).To(Equal(uint32(jksMagic)))
+	Expect(version).To(Equal(uint32(jksVersion)))
+
+	entries := make([]jksEntry, 0, count)
+	for range count {
+		var tag uint32
+		read(&tag)
+		Expect(tag).To(Equal(uint32(jksTrustedCertTag)))
+		e := jksEntry{alias: readUTF()}
+		read(&e.timestamp)
+		e.certType = readUTF()
+		var n uint32
+		read(&n)
+		e.der = make([]byte, n)
+		read(e.der)
+		entries = append(entries, e)
+	}
+	Expect(r.Len()).To(BeZero(), "trailing bytes")
+	return entries
+}
+
+// testCertificate returns a self-signed CA certificate valid from notBefore
+func testCertificate(cn string, notBefore time.Time) *x509.Certificate {
+	GinkgoHelper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	return cert
+}
+
+var _ = Describe("encodeJKSTruststore", func() {
+	It("stores every certificate of the PEM chain under a stable alias", func() {
+		root := testCertificate("root", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		intermediate := testCertificate("intermediate", time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC))
+		chain := append(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: intermediate.Raw}),
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw})...)
+
+		store, err := encodeJKSTruststore(chain, "changeit")
+		Expect(err).NotTo(HaveOccurred())
+
+		entries := decodeJKSTruststore(store, "changeit")
+		Expect(entries).To(Equal([]jksEntry{
+			{alias: "ca", timestamp: intermediate.NotBefore.UnixMilli(), certType: "X.509", der: intermediate.Raw},
+			{alias: "ca-1", timestamp: root.NotBefore.UnixMilli(), certType: "X.509", der: root.Raw},
+		}))
+
+		again, err := encodeJKSTruststore(chain, "changeit")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again).To(Equal(store))
+	})
+
+	It("skips PEM blocks that are not certificates", func() {
+		root := testCertificate("root", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+		data := append(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("key")}),
+			pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: root.Raw})...)
+
+		store, err := encodeJKSTruststore(data, "changeit")
+		Expect(err).NotTo(HaveOccurred())
+		entries := decodeJKSTruststore(store, "changeit")
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].der).To(Equal(root.Raw))
+	})
+
+	It("fails without a PEM certificate", func() {
+		_, err := encodeJKSTruststore(nil, "changeit")
+		Expect(err).To(MatchError(ContainSubstring("no PEM certificate found")))
+
+		_, err = encodeJKSTruststore(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: []byte("key")}), "changeit")
+		Expect(err).To(MatchError(ContainSubstring("no PEM certificate found")))
+	})
+})
@@ -0,0 +1,79 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+var (
+	// certificateSetReady reports 1 for CertificateSets with Ready=True and 0 otherwise
+	certificateSetReady = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "certificateset_ready",
+			Help: "Whether the CertificateSet has Ready=True (1) or not (0)",
+		},
+		[]string{"name", "namespace", "environment"},
+	)
+
+	// certificateSetReconcileErrors counts reconciliations that returned an error
+	certificateSetReconcileErrors = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "certificateset_reconcile_errors_total",
+			Help: "Total number of CertificateSet reconciliations that returned an error",
+		},
+	)
+
+	// certificateSetTimeToReady observes the time from CertificateSet creation until it becomes Ready
+	certificateSetTimeToReady = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "certificateset_time_to_ready_seconds",
+			Help:    "Time from CertificateSet creation until Ready=True",
+			Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+		},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(certificateSetReady, certificateSetReconcileErrors, certificateSetTimeToReady)
+}
+
+// recordStatusMetrics updates the readiness metrics from the status that was just written
+func recordStatusMetrics(cs, original *incloudiov1alpha1.CertificateSet) {
+	ready := meta.IsStatusConditionTrue(cs.Status.Conditions, ConditionTypeReady)
+	value := 0.0
+	if ready {
+		value = 1
+	}
+	certificateSetReady.WithLabelValues(cs.Name, cs.Namespace, string(cs.Spec.Environment)).Set(value)
+
+	if ready && !meta.IsStatusConditionTrue(original.Status.Conditions, ConditionTypeReady) {
+		certificateSetTimeToReady.Observe(time.Since(cs.CreationTimestamp.Time).Seconds())
+	}
+}
+
+// forgetStatusMetrics removes the per-object metric series of a deleted CertificateSet
+func forgetStatusMetrics(key types.NamespacedName) {
+	certificateSetReady.DeletePartialMatch(prometheus.Labels{"name": key.Name, "namespace": key.Namespace})
+}
@@ -16,16 +16,32 @@ limitations under the License.
 
 package controller
 
-import incloudiov1alpha1 "certificate-set/api/v1alpha1"
+import (
+	"k8s.io/apimachinery/pkg/types"
 
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+// The CertificateSet CRD limits metadata.name to 253 characters minus the longest suffix (-front-proxy-client);
+// the limit has to be lowered when a longer suffix is added.
 const (
 	suffixCA            = "-ca"
 	suffixSuperAdmin    = "-super-admin"
 	suffixETCD          = "-etcd"
+	suffixETCDServer    = "-etcd-server"
+	suffixETCDPeer      = "-etcd-peer"
 	suffixProxy         = "-proxy"
+	suffixFrontProxy    = "-front-proxy-client"
 	suffixCAOIDC        = "-ca-oidc"
 	suffixKubeconfig    = "-kubeconfig"
 	suffixArgoCDCluster = "-argocd-cluster"
+	suffixCABundle      = "-ca-bundle"
+	suffixBundle        = "-bundle"
+	suffixCAJKS         = "-ca-jks"
+	suffixFullChain     = "-fullchain"
+	suffixClusterInfo   = "-cluster-info"
+	suffixCACert        = "-ca-cert"
+	suffixSelfSigned    = "-selfsigned"
 )
 
 // CAName returns the name for CA Certificate, Secret, and Issuer
@@ -33,6 +49,26 @@ func CAName(cs *incloudiov1alpha1.CertificateSet) string {
 	return cs.Name + suffixCA
 }
 
+// CASecretName returns the name of the Secret holding the CA key pair: the Secret from
+// spec.existingCASecretRef if set, otherwise the Secret issued for the CA Certificate
+func CASecretName(cs *incloudiov1alpha1.CertificateSet) string {
+	if usesExistingCA(cs) {
+		return cs.Spec.ExistingCASecretRef.Name
+	}
+	return CAName(cs)
+}
+
+// SelfSignedIssuerName returns the name of the SelfSigned Issuer that signs the CA with spec.selfSignedCA
+func SelfSignedIssuerName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixSelfSigned
+}
+
+// ClusterIssuerName returns the name for CA ClusterIssuer. ClusterIssuers are cluster-scoped,
+// so the namespace is part of the name to keep it unique.
+func ClusterIssuerName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Namespace + "-" + CAName(cs)
+}
+
 // SuperAdminName returns the name for super-admin Certificate and Secret
 func SuperAdminName(cs *incloudiov1alpha1.CertificateSet) string {
 	return cs.Name + suffixSuperAdmin
@@ -43,6 +79,21 @@ func ETCDName(cs *incloudiov1alpha1.CertificateSet) string {
 	return cs.Name + suffixETCD
 }
 
+// ETCDServerName returns the name for the etcd server Certificate and Secret
+func ETCDServerName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixETCDServer
+}
+
+// FrontProxyClientName returns the name for the front-proxy client Certificate and Secret
+func FrontProxyClientName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixFrontProxy
+}
+
+// ETCDPeerName returns the name for the etcd peer Certificate and Secret
+func ETCDPeerName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixETCDPeer
+}
+
 // ProxyName returns the name for Proxy Certificate
 func ProxyName(cs *incloudiov1alpha1.CertificateSet) string {
 	return cs.Name + suffixProxy
@@ -63,17 +114,264 @@ func ArgoCDClusterName(cs *incloudiov1alpha1.CertificateSet) string {
 	return cs.Name + suffixArgoCDCluster
 }
 
-// AllCertificateNames returns all Certificate names that should be created for this CertificateSet
-func AllCertificateNames(cs *incloudiov1alpha1.CertificateSet) []string {
-	names := []string{CAName(cs)}
+// CABundleName returns the name for the CA trust bundle Secret
+func CABundleName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixCABundle
+}
+
+// ETCDCABundleName returns the name for the etcd CA bundle Secret
+func ETCDCABundleName(cs *incloudiov1alpha1.CertificateSet) string {
+	return ETCDName(cs) + suffixCABundle
+}
+
+// ProxyCABundleName returns the name for the Proxy CA bundle Secret
+func ProxyCABundleName(cs *incloudiov1alpha1.CertificateSet) string {
+	return ProxyName(cs) + suffixCABundle
+}
+
+// CAOIDCBundleName returns the name for the OIDC CA bundle Secret
+func CAOIDCBundleName(cs *incloudiov1alpha1.CertificateSet) string {
+	return CAOIDCName(cs) + suffixBundle
+}
+
+// CAJKSName returns the name for the JKS truststore Secret
+func CAJKSName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixCAJKS
+}
+
+// FullChainName returns the name for the full chain PEM Secret
+func FullChainName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixFullChain
+}
+
+// ClusterInfoName returns the name for the cluster-info ConfigMap
+func ClusterInfoName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixClusterInfo
+}
+
+// CACertConfigMapName returns the name for the CA certificate ConfigMap
+func CACertConfigMapName(cs *incloudiov1alpha1.CertificateSet) string {
+	return cs.Name + suffixCACert
+}
+
+// ClientCertificateName returns the name for an additional client Certificate and Secret
+func ClientCertificateName(cs *incloudiov1alpha1.CertificateSet, clientName string) string {
+	return cs.Name + "-" + clientName
+}
+
+// ClientKubeconfigName returns the name for the kubeconfig Secret of an additional client certificate
+func ClientKubeconfigName(cs *incloudiov1alpha1.CertificateSet, clientName string) string {
+	return ClientCertificateName(cs, clientName) + suffixKubeconfig
+}
+
+// TargetNamespace returns the namespace for Certificates, the Issuer and derived Secrets
+func TargetNamespace(cs *incloudiov1alpha1.CertificateSet) string {
+	if cs.Spec.TargetNamespace != "" {
+		return cs.Spec.TargetNamespace
+	}
+	return cs.Namespace
+}
+
+// ArgoCDClusterNamespace returns the namespace for ArgoCD cluster Secret
+func ArgoCDClusterNamespace(cs *incloudiov1alpha1.CertificateSet) string {
+	if cs.Spec.ArgoCDNamespace != "" {
+		return cs.Spec.ArgoCDNamespace
+	}
+	return DefaultArgoCDNamespace
+}
+
+// ArgoCDClusterSecrets returns the namespace and name of every ArgoCD cluster Secret:
+// one per spec.argocdTargets entry, or the single Secret in ArgoCDClusterNamespace
+func ArgoCDClusterSecrets(cs *incloudiov1alpha1.CertificateSet) []types.NamespacedName {
+	if len(cs.Spec.ArgoCDTargets) == 0 {
+		return []types.NamespacedName{{Namespace: ArgoCDClusterNamespace(cs), Name: ArgoCDClusterName(cs)}}
+	}
+	secrets := make([]types.NamespacedName, 0, len(cs.Spec.ArgoCDTargets))
+	for _, target := range cs.Spec.ArgoCDTargets {
+		secrets = append(secrets, types.NamespacedName{Namespace: target.Namespace, Name: target.NamePrefix + ArgoCDClusterName(cs)})
+	}
+	return secrets
+}
+
+// KubeconfigMirrorSecrets returns the copy of the kubeconfig Secret in every spec.kubeconfigMirrorNamespaces
+// namespace. The target namespace already holds the source Secret and is skipped.
+func KubeconfigMirrorSecrets(cs *incloudiov1alpha1.CertificateSet) []types.NamespacedName {
+	secrets := make([]types.NamespacedName, 0, len(cs.Spec.KubeconfigMirrorNamespaces))
+	for _, ns := range cs.Spec.KubeconfigMirrorNamespaces {
+		if ns == TargetNamespace(cs) {
+			continue
+		}
+		secrets = append(secrets, types.NamespacedName{Namespace: ns, Name: KubeconfigName(cs)})
+	}
+	return secrets
+}
+
+// ManagedResource is a resource the controller creates for a CertificateSet
+type ManagedResource struct {
+	// Kind is the resource kind (Certificate, Issuer, ClusterIssuer, Secret or ConfigMap)
+	Kind string
+	// Name is the name of the resource
+	Name string
+	// Namespace is the namespace of the resource, empty for cluster-scoped resources
+	Namespace string
+	// Purpose is the SecretPurpose of the Secret, of the Secret a Certificate is issued into,
+	// or of the CA Secret an Issuer signs with. ConfigMaps use cluster-info, oidc-ca-bundle and ca-cert.
+	Purpose string
+}
+
+const (
+	// PurposeClusterInfo is the purpose of the cluster-info ConfigMap
+	PurposeClusterInfo = "cluster-info"
+	// PurposeOIDCCABundle is the purpose of the OIDC CA bundle ConfigMap
+	PurposeOIDCCABundle = "oidc-ca-bundle"
+	// PurposeCACert is the purpose of the CA certificate ConfigMap
+	PurposeCACert = "ca-cert"
+)
+
+// managedCertificates returns every Certificate that should be created for this CertificateSet.
+// Each one is backed by a Secret with the same name.
+func managedCertificates(cs *incloudiov1alpha1.CertificateSet) []ManagedResource {
+	ns := TargetNamespace(cs)
+	certificate := func(name string, purpose incloudiov1alpha1.SecretPurpose) ManagedResource {
+		return ManagedResource{Kind: "Certificate", Name: name, Namespace: ns, Purpose: string(purpose)}
+	}
+
+	var certs []ManagedResource
+	if !usesExistingCA(cs) {
+		certs = append(certs, certificate(CAName(cs), incloudiov1alpha1.SecretPurposeCA))
+	}
+
+	if generateETCD(cs) {
+		certs = append(certs, certificate(ETCDName(cs), incloudiov1alpha1.SecretPurposeETCD))
+	}
+
+	if generateETCDLeafCertificates(cs) {
+		certs = append(certs,
+			certificate(ETCDServerName(cs), incloudiov1alpha1.SecretPurposeETCDServer),
+			certificate(ETCDPeerName(cs), incloudiov1alpha1.SecretPurposeETCDPeer),
+		)
+	}
+
+	if generateProxy(cs) {
+		certs = append(certs, certificate(ProxyName(cs), incloudiov1alpha1.SecretPurposeProxy))
+	}
+
+	if generateFrontProxyClientCertificate(cs) {
+		certs = append(certs, certificate(FrontProxyClientName(cs), incloudiov1alpha1.SecretPurposeFrontProxyClient))
+	}
 
 	if isSystemOrInfra(cs.Spec.Environment) {
-		names = append(names, ETCDName(cs), ProxyName(cs), CAOIDCName(cs))
+		certs = append(certs, certificate(CAOIDCName(cs), incloudiov1alpha1.SecretPurposeCAOIDC))
+	}
+
+	if needsSuperAdmin(cs) {
+		certs = append(certs, certificate(SuperAdminName(cs), incloudiov1alpha1.SecretPurposeSuperAdmin))
+	}
+
+	for _, client := range cs.Spec.ClientCertificates {
+		certs = append(certs, certificate(ClientCertificateName(cs, client.Name), incloudiov1alpha1.SecretPurposeClientCertificate))
+	}
+
+	return certs
+}
+
+// AllManagedResources returns every Certificate, Issuer, ClusterIssuer, Secret and ConfigMap that reconciliation
+// would create for this CertificateSet, including the ArgoCD cluster Secrets outside the target namespace.
+// Secrets that are not directly issued by cert-manager carry their SecretPurpose as recorded in status.
+func AllManagedResources(cs *incloudiov1alpha1.CertificateSet) []ManagedResource {
+	ns := TargetNamespace(cs)
+	var resources []ManagedResource
+
+	for _, cert := range managedCertificates(cs) {
+		secret := cert
+		secret.Kind = "Secret"
+		resources = append(resources, cert, secret)
+	}
+
+	if usesSelfSignedCA(cs) {
+		resources = append(resources, ManagedResource{Kind: "Issuer", Name: SelfSignedIssuerName(cs), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeCA)})
+	}
+
+	if needsClientCertificates(cs) {
+		if usesClusterIssuer(cs) {
+			resources = append(resources, ManagedResource{Kind: "ClusterIssuer", Name: ClusterIssuerName(cs), Purpose: string(incloudiov1alpha1.SecretPurposeCA)})
+		} else {
+			resources = append(resources, ManagedResource{Kind: "Issuer", Name: CAName(cs), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeCA)})
+		}
+	}
+
+	if generateETCDLeafCertificates(cs) {
+		resources = append(resources, ManagedResource{Kind: "Issuer", Name: ETCDName(cs), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeETCD)})
+	}
+
+	if generateFrontProxyClientCertificate(cs) {
+		resources = append(resources, ManagedResource{Kind: "Issuer", Name: ProxyName(cs), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeProxy)})
+	}
+
+	if cs.Spec.Kubeconfig {
+		resources = append(resources, ManagedResource{Kind: "Secret", Name: KubeconfigName(cs), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeKubeconfig)})
+		for _, secret := range KubeconfigMirrorSecrets(cs) {
+			resources = append(resources, ManagedResource{Kind: "Secret", Name: secret.Name, Namespace: secret.Namespace, Purpose: string(incloudiov1alpha1.SecretPurposeKubeconfigMirror)})
+		}
+	}
+
+	if cs.Spec.ArgocdCluster {
+		for _, secret := range ArgoCDClusterSecrets(cs) {
+			resources = append(resources, ManagedResource{Kind: "Secret", Name: secret.Name, Namespace: secret.Namespace, Purpose: string(incloudiov1alpha1.SecretPurposeArgoCDCluster)})
+		}
+	}
+
+	for _, client := range cs.Spec.ClientCertificates {
+		resources = append(resources, ManagedResource{Kind: "Secret", Name: ClientKubeconfigName(cs, client.Name), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeClientKubeconfig)})
 	}
 
-	if cs.Spec.Kubeconfig || cs.Spec.ArgocdCluster {
-		names = append(names, SuperAdminName(cs))
+	if cs.Spec.PublishCABundle {
+		resources = append(resources, ManagedResource{Kind: "Secret", Name: CABundleName(cs), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeCABundle)})
 	}
 
+	for _, bundle := range componentCABundles(cs) {
+		resources = append(resources, ManagedResource{Kind: "Secret", Name: bundle.name, Namespace: ns, Purpose: string(bundle.purpose)})
+	}
+
+	if cs.Spec.JksCABundle {
+		resources = append(resources, ManagedResource{Kind: "Secret", Name: CAJKSName(cs), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeCAJKS)})
+	}
+
+	if cs.Spec.FullChainSecret {
+		resources = append(resources, ManagedResource{Kind: "Secret", Name: FullChainName(cs), Namespace: ns, Purpose: string(incloudiov1alpha1.SecretPurposeFullChain)})
+	}
+
+	if generateClusterInfo(cs) {
+		resources = append(resources, ManagedResource{Kind: "ConfigMap", Name: ClusterInfoName(cs), Namespace: ns, Purpose: PurposeClusterInfo})
+	}
+
+	if cs.Spec.OIDCCABundleConfigMap != "" {
+		resources = append(resources, ManagedResource{Kind: "ConfigMap", Name: cs.Spec.OIDCCABundleConfigMap, Namespace: ns, Purpose: PurposeOIDCCABundle})
+	}
+
+	if cs.Spec.PublishCAConfigMap {
+		resources = append(resources, ManagedResource{Kind: "ConfigMap", Name: CACertConfigMapName(cs), Namespace: ns, Purpose: PurposeCACert})
+	}
+
+	return resources
+}
+
+// PlannedResources returns all resources that reconciliation would create for this CertificateSet
+func PlannedResources(cs *incloudiov1alpha1.CertificateSet) []incloudiov1alpha1.PlannedResource {
+	managed := AllManagedResources(cs)
+	resources := make([]incloudiov1alpha1.PlannedResource, 0, len(managed))
+	for _, res := range managed {
+		resources = append(resources, incloudiov1alpha1.PlannedResource{Kind: res.Kind, Name: res.Name, Namespace: res.Namespace})
+	}
+	return resources
+}
+
+// AllCertificateNames returns all Certificate names that should be created for this CertificateSet
+func AllCertificateNames(cs *incloudiov1alpha1.CertificateSet) []string {
+	certs := managedCertificates(cs)
+	names := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		names = append(names, cert.Name)
+	}
 	return names
 }
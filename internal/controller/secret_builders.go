@@ -18,12 +18,17 @@ package controller
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"maps"
+	"net/url"
+	"strconv"
+	"strings"
 	"text/template"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	incloudiov1alpha1 "certificate-set/api/v1alpha1"
 )
@@ -38,10 +43,20 @@ type CertificateData struct {
 // kubeconfigData holds data for kubeconfig template rendering
 type kubeconfigData struct {
 	ClusterName string
+	ContextName string
+	UserName    string
 	Server      string
 	CACert      string
 	TLSCert     string
 	TLSKey      string
+	Token       string
+
+	// Exec plugin settings and the super-admin Secret it reads, for kubeconfigAuthMode=exec
+	ExecAPIVersion  string
+	ExecCommand     string
+	ExecArgs        []string
+	SecretName      string
+	SecretNamespace string
 }
 
 var kubeconfigTemplate = template.Must(template.New("kubeconfig").Parse(`apiVersion: v1
@@ -53,71 +68,484 @@ clusters:
 contexts:
     - context:
         cluster: {{.ClusterName}}
-        user: {{.ClusterName}}-super-admin
-      name: {{.ClusterName}}-super-admin@{{.ClusterName}}
-current-context: {{.ClusterName}}-super-admin@{{.ClusterName}}
+        user: {{.UserName}}
+      name: {{.ContextName}}
+current-context: {{.ContextName}}
 kind: Config
 users:
-    - name: {{.ClusterName}}-super-admin
+    - name: {{.UserName}}
       user:
         client-certificate-data: {{.TLSCert}}
         client-key-data: {{.TLSKey}}`))
 
+var kubeconfigTokenTemplate = template.Must(template.New("kubeconfig-token").Parse(`apiVersion: v1
+clusters:
+    - cluster:
+        certificate-authority-data: {{.CACert}}
+        server: {{.Server}}
+      name: {{.ClusterName}}
+contexts:
+    - context:
+        cluster: {{.ClusterName}}
+        user: {{.UserName}}
+      name: {{.ContextName}}
+current-context: {{.ContextName}}
+kind: Config
+users:
+    - name: {{.UserName}}
+      user:
+        token: {{.Token}}`))
+
+// Values set by users are quoted: a Go-quoted string is a valid YAML double-quoted scalar
+var kubeconfigExecTemplate = template.Must(template.New("kubeconfig-exec").Funcs(template.FuncMap{"quote": strconv.Quote}).Parse(`apiVersion: v1
+clusters:
+    - cluster:
+        certificate-authority-data: {{.CACert}}
+        server: {{.Server}}
+      name: {{.ClusterName}}
+contexts:
+    - context:
+        cluster: {{.ClusterName}}
+        user: {{.UserName}}
+      name: {{.ContextName}}
+current-context: {{.ContextName}}
+kind: Config
+users:
+    - name: {{.UserName}}
+      user:
+        exec:
+            apiVersion: {{.ExecAPIVersion}}
+            command: {{quote .ExecCommand}}
+{{- if .ExecArgs}}
+            args:
+{{- range .ExecArgs}}
+                - {{quote .}}
+{{- end}}
+{{- end}}
+            env:
+                - name: CERTIFICATESET_SECRET_NAMESPACE
+                  value: {{quote .SecretNamespace}}
+                - name: CERTIFICATESET_SECRET_NAME
+                  value: {{quote .SecretName}}
+            interactiveMode: Never`))
+
+var clusterInfoTemplate = template.Must(template.New("cluster-info").Parse(`apiVersion: v1
+clusters:
+    - cluster:
+        certificate-authority-data: {{.CACert}}
+        server: {{.Server}}
+      name: {{.ClusterName}}
+contexts: null
+current-context: ""
+kind: Config
+preferences: {}
+users: null`))
+
+// caData is left out with insecure: client-go rejects a root CA combined with skipped verification
 var argoCDConfigTemplate = template.Must(template.New("argocd").Parse(`{
   "tlsClientConfig": {
+{{- if not .Insecure}}
     "caData": "{{.CACert}}",
+{{- end}}
     "certData": "{{.TLSCert}}",
-    "insecure": false,
+    "insecure": {{.Insecure}},
     "keyData": "{{.TLSKey}}"
   }
 }`))
 
-func buildKubeconfigSecret(cs *incloudiov1alpha1.CertificateSet, certData CertificateData) (*corev1.Secret, error) {
-	var buf bytes.Buffer
-	if err := kubeconfigTemplate.Execute(&buf, kubeconfigData{
-		ClusterName: cs.Name,
+// argoCDConfigData holds data for ArgoCD config template rendering
+type argoCDConfigData struct {
+	CertificateData
+	Insecure bool
+}
+
+// derivedSecretLabels returns labels for derived Secrets: child labels merged with spec.secretLabels
+func derivedSecretLabels(cs *incloudiov1alpha1.CertificateSet) map[string]string {
+	labels := make(map[string]string)
+	maps.Copy(labels, childLabels(cs))
+	maps.Copy(labels, cs.Spec.SecretLabels)
+	return labels
+}
+
+// derivedSecretAnnotations returns annotations for derived Secrets: CertificateSet annotations merged with spec.secretAnnotations
+func derivedSecretAnnotations(cs *incloudiov1alpha1.CertificateSet) map[string]string {
+	annotations := copyAnnotationsForChildResource(cs.Annotations)
+	if len(cs.Spec.SecretAnnotations) == 0 {
+		return annotations
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	maps.Copy(annotations, cs.Spec.SecretAnnotations)
+	return annotations
+}
+
+// buildKubeconfigSecret renders the kubeconfig Secret with custom, or the built-in template when it is nil.
+// The token is only used when kubeconfigAuthMode is token and the exec plugin only with exec; otherwise the client
+// certificate from certData is embedded.
+func buildKubeconfigSecret(cs *incloudiov1alpha1.CertificateSet, certData CertificateData, token string, custom *template.Template) (*corev1.Secret, error) {
+	tmpl := kubeconfigTemplate
+	switch {
+	case usesTokenAuth(cs):
+		tmpl = kubeconfigTokenTemplate
+	case usesExecAuth(cs):
+		tmpl = kubeconfigExecTemplate
+	}
+	if custom != nil {
+		tmpl = custom
+	}
+
+	contextName := cs.Spec.KubeconfigContextName
+	if contextName == "" {
+		contextName = SuperAdminName(cs) + "@" + kubeconfigClusterName(cs)
+	}
+
+	data := kubeconfigData{
+		ClusterName:     kubeconfigClusterName(cs),
+		ContextName:     contextName,
+		UserName:        SuperAdminName(cs),
+		Server:          cs.Spec.KubeconfigEndpoint,
+		CACert:          certData.CACert,
+		TLSCert:         certData.TLSCert,
+		TLSKey:          certData.TLSKey,
+		Token:           token,
+		SecretName:      SuperAdminName(cs),
+		SecretNamespace: TargetNamespace(cs),
+	}
+	if exec := cs.Spec.KubeconfigExec; exec != nil {
+		data.ExecAPIVersion = exec.APIVersion
+		if data.ExecAPIVersion == "" {
+			data.ExecAPIVersion = defaultExecAPIVersion
+		}
+		data.ExecCommand = exec.Command
+		data.ExecArgs = exec.Args
+	}
+
+	secret, err := newKubeconfigSecret(cs, KubeconfigName(cs), tmpl, data)
+	if err != nil {
+		return nil, err
+	}
+	secret.Type = kubeconfigSecretType(cs)
+	return secret, nil
+}
+
+// buildKubeconfigMirrorSecret copies the data and type of the rendered kubeconfig Secret to the given namespace and name
+func buildKubeconfigMirrorSecret(cs *incloudiov1alpha1.CertificateSet, source *corev1.Secret, key types.NamespacedName) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        key.Name,
+			Namespace:   key.Namespace,
+			Labels:      derivedSecretLabels(cs),
+			Annotations: derivedSecretAnnotations(cs),
+		},
+		Type: source.Type,
+		Data: maps.Clone(source.Data),
+	}
+}
+
+// buildClientKubeconfigSecret renders the kubeconfig Secret for an additional client certificate
+func buildClientKubeconfigSecret(cs *incloudiov1alpha1.CertificateSet, client incloudiov1alpha1.ClientCertSpec, certData CertificateData) (*corev1.Secret, error) {
+	return newKubeconfigSecret(cs, ClientKubeconfigName(cs, client.Name), kubeconfigTemplate, kubeconfigData{
+		ClusterName: kubeconfigClusterName(cs),
+		ContextName: ClientCertificateName(cs, client.Name) + "@" + kubeconfigClusterName(cs),
+		UserName:    ClientCertificateName(cs, client.Name),
 		Server:      cs.Spec.KubeconfigEndpoint,
 		CACert:      certData.CACert,
 		TLSCert:     certData.TLSCert,
 		TLSKey:      certData.TLSKey,
-	}); err != nil {
-		return nil, fmt.Errorf("failed to render kubeconfig template: %w", err)
+	})
+}
+
+// validateKubeconfigEndpoint checks that endpoint is an http(s) URL with a host. Bracketed IPv6
+// literals, custom ports and paths are accepted; the endpoint itself is used verbatim.
+func validateKubeconfigEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrInvalidEndpoint, endpoint, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%w %q: scheme must be http or https", ErrInvalidEndpoint, endpoint)
+	}
+	if u.Hostname() == "" {
+		return fmt.Errorf("%w %q: host is empty", ErrInvalidEndpoint, endpoint)
+	}
+	if strings.Contains(u.Hostname(), ":") && !strings.HasPrefix(u.Host, "[") {
+		return fmt.Errorf("%w %q: IPv6 literals must be enclosed in brackets", ErrInvalidEndpoint, endpoint)
+	}
+	return nil
+}
+
+// kubeconfigClusterName returns spec.kubeconfigClusterName, falling back to the CertificateSet name
+func kubeconfigClusterName(cs *incloudiov1alpha1.CertificateSet) string {
+	if cs.Spec.KubeconfigClusterName != "" {
+		return cs.Spec.KubeconfigClusterName
+	}
+	return cs.Name
+}
+
+// kubeconfigSecretKey returns spec.kubeconfigSecretKey, falling back to "value"
+func kubeconfigSecretKey(cs *incloudiov1alpha1.CertificateSet) string {
+	if cs.Spec.KubeconfigSecretKey != "" {
+		return cs.Spec.KubeconfigSecretKey
+	}
+	return "value"
+}
+
+// kubeconfigSecretType returns spec.kubeconfigSecretType, falling back to Opaque
+func kubeconfigSecretType(cs *incloudiov1alpha1.CertificateSet) corev1.SecretType {
+	if cs.Spec.KubeconfigSecretType != "" {
+		return corev1.SecretType(cs.Spec.KubeconfigSecretType)
+	}
+	return corev1.SecretTypeOpaque
+}
+
+// newKubeconfigSecret renders tmpl into a kubeconfig Secret with the given name
+func newKubeconfigSecret(cs *incloudiov1alpha1.CertificateSet, name string, tmpl *template.Template, data kubeconfigData) (*corev1.Secret, error) {
+	if err := validateKubeconfigEndpoint(data.Server); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("%w: kubeconfig: %w", ErrTemplateRender, err)
 	}
 	kubeconfigContent := buf.String()
 
-	labels := make(map[string]string)
-	maps.Copy(labels, cs.Labels)
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   TargetNamespace(cs),
+			Labels:      derivedSecretLabels(cs),
+			Annotations: derivedSecretAnnotations(cs),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			kubeconfigSecretKey(cs): []byte(kubeconfigContent),
+		},
+	}, nil
+}
 
+// buildCAJKSSecret creates the Secret holding the JKS truststore with the CA certificate
+func buildCAJKSSecret(cs *incloudiov1alpha1.CertificateSet, truststore []byte) *corev1.Secret {
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        KubeconfigName(cs),
-			Namespace:   cs.Namespace,
-			Labels:      labels,
-			Annotations: copyAnnotationsForChildResource(cs.Annotations),
+			Name:        CAJKSName(cs),
+			Namespace:   TargetNamespace(cs),
+			Labels:      derivedSecretLabels(cs),
+			Annotations: derivedSecretAnnotations(cs),
 		},
 		Type: corev1.SecretTypeOpaque,
 		Data: map[string][]byte{
-			"value": []byte(kubeconfigContent),
+			"truststore.jks": truststore,
+		},
+	}
+}
+
+// buildCABundleSecret creates the Secret holding only the CA certificate, without its private key
+func buildCABundleSecret(cs *incloudiov1alpha1.CertificateSet, caPEM []byte) *corev1.Secret {
+	return buildCABundleSecretWithName(cs, CABundleName(cs), caPEM)
+}
+
+// buildCABundleSecretWithName creates a Secret with the given name holding only ca.crt
+func buildCABundleSecretWithName(cs *incloudiov1alpha1.CertificateSet, name string, caPEM []byte) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   TargetNamespace(cs),
+			Labels:      derivedSecretLabels(cs),
+			Annotations: derivedSecretAnnotations(cs),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"ca.crt": caPEM,
+		},
+	}
+}
+
+// componentCABundle describes a ca.crt-only Secret published for the etcd, Proxy or OIDC CA
+type componentCABundle struct {
+	name    string
+	purpose incloudiov1alpha1.SecretPurpose
+	// source is the cert-manager Secret the CA certificate is read from, key is its data key
+	source string
+	key    string
+}
+
+// componentCABundles returns the CA bundles published with spec.publishComponentCABundles for the CAs that
+// are generated. CA certificates are tls.crt of their Secret; the infra OIDC certificate is signed by an external
+// issuer and is not a CA, so its bundle is the issuer CA (ca.crt).
+func componentCABundles(cs *incloudiov1alpha1.CertificateSet) []componentCABundle {
+	if !cs.Spec.PublishComponentCABundles {
+		return nil
+	}
+
+	var bundles []componentCABundle
+	if generateETCD(cs) {
+		bundles = append(bundles, componentCABundle{ETCDCABundleName(cs), incloudiov1alpha1.SecretPurposeETCDCABundle, ETCDName(cs), "tls.crt"})
+	}
+	if generateProxy(cs) {
+		bundles = append(bundles, componentCABundle{ProxyCABundleName(cs), incloudiov1alpha1.SecretPurposeProxyCABundle, ProxyName(cs), "tls.crt"})
+	}
+	if isSystemOrInfra(cs.Spec.Environment) {
+		key := "tls.crt"
+		if cs.Spec.Environment == incloudiov1alpha1.EnvironmentInfra {
+			key = "ca.crt"
+		}
+		bundles = append(bundles, componentCABundle{CAOIDCBundleName(cs), incloudiov1alpha1.SecretPurposeCAOIDCBundle, CAOIDCName(cs), key})
+	}
+	return bundles
+}
+
+// fullChainKey is the Secret key holding the PEM chain, as expected by ingress controllers and proxies
+const fullChainKey = "fullchain.pem"
+
+// buildFullChainSecret creates the Secret holding the super-admin certificate followed by the CA certificate
+func buildFullChainSecret(cs *incloudiov1alpha1.CertificateSet, certData CertificateData) (*corev1.Secret, error) {
+	var chain []byte
+	for _, pem := range []string{certData.TLSCert, certData.CACert} {
+		decoded, err := base64.StdEncoding.DecodeString(pem)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode certificate: %w", err)
+		}
+		chain = append(chain, decoded...)
+		if len(chain) > 0 && chain[len(chain)-1] != '\n' {
+			chain = append(chain, '\n')
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        FullChainName(cs),
+			Namespace:   TargetNamespace(cs),
+			Labels:      derivedSecretLabels(cs),
+			Annotations: derivedSecretAnnotations(cs),
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			fullChainKey: chain,
 		},
 	}, nil
 }
 
-func buildArgoCDClusterSecret(cs *incloudiov1alpha1.CertificateSet, certData CertificateData) (*corev1.Secret, error) {
+// buildOIDCCABundleConfigMap creates the ConfigMap holding the OIDC issuer CA bundle
+func buildOIDCCABundleConfigMap(cs *incloudiov1alpha1.CertificateSet, caPEM []byte) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        cs.Spec.OIDCCABundleConfigMap,
+			Namespace:   TargetNamespace(cs),
+			Labels:      childLabels(cs),
+			Annotations: copyAnnotationsForChildResource(cs.Annotations),
+		},
+		Data: map[string]string{
+			"ca.crt": string(caPEM),
+		},
+	}
+}
+
+// caConfigMapKey returns spec.caConfigMapKey, falling back to "ca.crt"
+func caConfigMapKey(cs *incloudiov1alpha1.CertificateSet) string {
+	if cs.Spec.CAConfigMapKey != "" {
+		return cs.Spec.CAConfigMapKey
+	}
+	return "ca.crt"
+}
+
+// buildCACertConfigMap creates the ConfigMap holding the CA certificate under spec.caConfigMapKey
+func buildCACertConfigMap(cs *incloudiov1alpha1.CertificateSet, caPEM []byte) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        CACertConfigMapName(cs),
+			Namespace:   TargetNamespace(cs),
+			Labels:      childLabels(cs),
+			Annotations: copyAnnotationsForChildResource(cs.Annotations),
+		},
+		Data: map[string]string{
+			caConfigMapKey(cs): string(caPEM),
+		},
+	}
+}
+
+// clusterInfoKey is the ConfigMap key bootstrap tooling reads, as in kube-public/cluster-info
+const clusterInfoKey = "kubeconfig"
+
+// generateClusterInfo reports whether the cluster-info ConfigMap is created
+func generateClusterInfo(cs *incloudiov1alpha1.CertificateSet) bool {
+	return cs.Spec.Kubeconfig && cs.Spec.GenerateClusterInfo
+}
+
+// buildClusterInfoConfigMap renders the cluster-info ConfigMap with the CA and server of the kubeconfig
+func buildClusterInfoConfigMap(cs *incloudiov1alpha1.CertificateSet, certData CertificateData) (*corev1.ConfigMap, error) {
+	if err := validateKubeconfigEndpoint(cs.Spec.KubeconfigEndpoint); err != nil {
+		return nil, err
+	}
+
 	var buf bytes.Buffer
-	if err := argoCDConfigTemplate.Execute(&buf, certData); err != nil {
-		return nil, fmt.Errorf("failed to render ArgoCD config template: %w", err)
+	if err := clusterInfoTemplate.Execute(&buf, kubeconfigData{
+		ClusterName: kubeconfigClusterName(cs),
+		Server:      cs.Spec.KubeconfigEndpoint,
+		CACert:      certData.CACert,
+	}); err != nil {
+		return nil, fmt.Errorf("%w: cluster-info: %w", ErrTemplateRender, err)
 	}
 
-	labels := make(map[string]string)
-	maps.Copy(labels, cs.Labels)
-	labels["argocd.argoproj.io/secret-type"] = "cluster"
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        ClusterInfoName(cs),
+			Namespace:   TargetNamespace(cs),
+			Labels:      childLabels(cs),
+			Annotations: copyAnnotationsForChildResource(cs.Annotations),
+		},
+		Data: map[string]string{
+			clusterInfoKey: buf.String(),
+		},
+	}, nil
+}
 
-	return &corev1.Secret{
+// usesTokenAuth reports whether the kubeconfig authenticates with a bearer token
+func usesTokenAuth(cs *incloudiov1alpha1.CertificateSet) bool {
+	return cs.Spec.KubeconfigAuthMode == incloudiov1alpha1.KubeconfigAuthModeToken
+}
+
+// defaultExecAPIVersion is the ExecCredential version used when kubeconfigExec.apiVersion is empty
+const defaultExecAPIVersion = "client.authentication.k8s.io/v1"
+
+// usesExecAuth reports whether the kubeconfig authenticates through a credential plugin
+func usesExecAuth(cs *incloudiov1alpha1.CertificateSet) bool {
+	return cs.Spec.KubeconfigAuthMode == incloudiov1alpha1.KubeconfigAuthModeExec && cs.Spec.KubeconfigExec != nil
+}
+
+// argoCDSecretTypeLabel returns spec.argocdSecretTypeLabel, falling back to the standard ArgoCD label
+func argoCDSecretTypeLabel(cs *incloudiov1alpha1.CertificateSet) string {
+	if cs.Spec.ArgoCDSecretTypeLabel != "" {
+		return cs.Spec.ArgoCDSecretTypeLabel
+	}
+	return "argocd.argoproj.io/secret-type"
+}
+
+// buildArgoCDClusterSecret renders the ArgoCD cluster Secret with the given namespace and name
+func buildArgoCDClusterSecret(cs *incloudiov1alpha1.CertificateSet, certData CertificateData, key types.NamespacedName) (*corev1.Secret, error) {
+	if err := validateKubeconfigEndpoint(cs.Spec.KubeconfigEndpoint); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := argoCDConfigTemplate.Execute(&buf, argoCDConfigData{CertificateData: certData, Insecure: cs.Spec.ArgoCDInsecure}); err != nil {
+		return nil, fmt.Errorf("%w: ArgoCD config: %w", ErrTemplateRender, err)
+	}
+
+	// The secret-type label is required for ArgoCD to discover the cluster, so it wins over spec.secretLabels
+	labels := derivedSecretLabels(cs)
+	maps.Copy(labels, cs.Spec.ArgoCDClusterLabels)
+	if !cs.Spec.ArgoCDSkipSecretTypeLabel {
+		labels[argoCDSecretTypeLabel(cs)] = "cluster"
+	}
+
+	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        ArgoCDClusterName(cs),
-			Namespace:   ArgoCDNamespace,
+			Name:        key.Name,
+			Namespace:   key.Namespace,
 			Labels:      labels,
-			Annotations: copyAnnotationsForChildResource(cs.Annotations),
+			Annotations: derivedSecretAnnotations(cs),
 		},
 		Type: corev1.SecretTypeOpaque,
 		Data: map[string][]byte{
@@ -125,5 +553,18 @@ func buildArgoCDClusterSecret(cs *incloudiov1alpha1.CertificateSet, certData Cer
 			"name":   []byte(cs.Name),
 			"server": []byte(cs.Spec.KubeconfigEndpoint),
 		},
-	}, nil
+	}
+	if cs.Spec.ArgoCDProject != "" {
+		secret.Data["project"] = []byte(cs.Spec.ArgoCDProject)
+	}
+	return secret, nil
+}
+
+// argoCDClusterSecretKeys returns the data keys of the ArgoCD cluster Secret managed by the controller
+func argoCDClusterSecretKeys(cs *incloudiov1alpha1.CertificateSet) []string {
+	keys := []string{"config", "name", "server"}
+	if cs.Spec.ArgoCDProject != "" {
+		keys = append(keys, "project")
+	}
+	return keys
 }
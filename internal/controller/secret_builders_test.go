@@ -0,0 +1,167 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"text/template"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+var _ = Describe("Kubeconfig endpoint", func() {
+	newCertificateSet := func(endpoint string) *incloudiov1alpha1.CertificateSet {
+		return &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:        incloudiov1alpha1.EnvironmentClient,
+				Kubeconfig:         true,
+				KubeconfigEndpoint: endpoint,
+			},
+		}
+	}
+
+	DescribeTable("accepts valid endpoints and renders them verbatim",
+		func(endpoint string) {
+			Expect(validateKubeconfigEndpoint(endpoint)).To(Succeed())
+
+			secret, err := buildKubeconfigSecret(newCertificateSet(endpoint), CertificateData{}, "", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(secret.Data["value"])).To(ContainSubstring("server: " + endpoint + "\n"))
+		},
+		Entry("hostname with default port", "https://api.example.com"),
+		Entry("hostname with custom port", "https://api.example.com:6443"),
+		Entry("trailing slash", "https://api.example.com:6443/"),
+		Entry("IPv4 literal", "https://10.0.0.1:6443"),
+		Entry("IPv6 literal", "https://[fd00::1]"),
+		Entry("IPv6 literal with custom port", "https://[fd00::1]:6443"),
+		Entry("IPv6 literal with custom port and trailing slash", "https://[fd00::1]:6443/"),
+	)
+
+	DescribeTable("rejects invalid endpoints",
+		func(endpoint string) {
+			Expect(validateKubeconfigEndpoint(endpoint)).To(MatchError(ErrInvalidEndpoint))
+
+			_, err := buildKubeconfigSecret(newCertificateSet(endpoint), CertificateData{}, "", nil)
+			Expect(err).To(MatchError(ErrInvalidEndpoint))
+			Expect(reasonForError(err, "DerivedSecretsFailed")).To(Equal("InvalidEndpoint"))
+		},
+		Entry("missing scheme", "api.example.com:6443"),
+		Entry("unsupported scheme", "ftp://api.example.com"),
+		Entry("empty host", "https://:6443"),
+		Entry("unbracketed IPv6 literal", "https://fd00::1:6443"),
+		Entry("non-numeric port", "https://api.example.com:port"),
+	)
+
+	It("renders a custom kubeconfig template", func() {
+		tmpl := template.Must(template.New("custom").Option("missingkey=error").Parse(
+			"server: {{.Server}}\ntls-server-name: kubernetes\nuser: {{.UserName}}\n"))
+
+		secret, err := buildKubeconfigSecret(newCertificateSet("https://api.example.com"), CertificateData{}, "", tmpl)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(secret.Data["value"])).To(Equal("server: https://api.example.com\ntls-server-name: kubernetes\nuser: demo-super-admin\n"))
+	})
+
+	DescribeTable("renders the ArgoCD tlsClientConfig",
+		func(insecure bool, expected map[string]any) {
+			cs := newCertificateSet("https://api.example.com")
+			cs.Spec.ArgocdCluster = true
+			cs.Spec.ArgoCDInsecure = insecure
+			certData := CertificateData{CACert: "Y2E=", TLSCert: "Y3J0", TLSKey: "a2V5"}
+
+			secret, err := buildArgoCDClusterSecret(cs, certData, ArgoCDClusterSecrets(cs)[0])
+			Expect(err).NotTo(HaveOccurred())
+
+			var config struct {
+				TLSClientConfig map[string]any `json:"tlsClientConfig"`
+			}
+			Expect(json.Unmarshal(secret.Data["config"], &config)).To(Succeed())
+			Expect(config.TLSClientConfig).To(Equal(expected))
+		},
+		Entry("verifying the API server with the CA", false, map[string]any{
+			"caData": "Y2E=", "certData": "Y3J0", "insecure": false, "keyData": "a2V5",
+		}),
+		Entry("insecure without caData", true, map[string]any{
+			"certData": "Y3J0", "insecure": true, "keyData": "a2V5",
+		}),
+	)
+})
+
+var _ = Describe("Exec kubeconfig", func() {
+	It("renders a credential plugin that reads the super-admin Secret instead of embedding it", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Kubeconfig:         true,
+				KubeconfigEndpoint: "https://api.example.com:6443",
+				KubeconfigAuthMode: incloudiov1alpha1.KubeconfigAuthModeExec,
+				KubeconfigExec: &incloudiov1alpha1.KubeconfigExec{
+					Command: "/usr/local/bin/kubectl-certset",
+					Args:    []string{"credential", "--context=a: b"},
+				},
+			},
+		}
+
+		secret, err := buildKubeconfigSecret(cs, CertificateData{CACert: "Y2E=", TLSCert: "Y3J0", TLSKey: "a2V5"}, "", nil)
+		Expect(err).NotTo(HaveOccurred())
+		config, err := clientcmd.Load(secret.Data["value"])
+		Expect(err).NotTo(HaveOccurred())
+
+		user := config.AuthInfos["demo-super-admin"]
+		Expect(user).NotTo(BeNil())
+		Expect(user.ClientCertificateData).To(BeEmpty())
+		Expect(user.Exec).NotTo(BeNil())
+		Expect(user.Exec.APIVersion).To(Equal("client.authentication.k8s.io/v1"))
+		Expect(user.Exec.Command).To(Equal("/usr/local/bin/kubectl-certset"))
+		Expect(user.Exec.Args).To(Equal([]string{"credential", "--context=a: b"}))
+		Expect(user.Exec.Env).To(ConsistOf(
+			clientcmdapi.ExecEnvVar{Name: "CERTIFICATESET_SECRET_NAMESPACE", Value: "default"},
+			clientcmdapi.ExecEnvVar{Name: "CERTIFICATESET_SECRET_NAME", Value: "demo-super-admin"},
+		))
+		Expect(user.Exec.InteractiveMode).To(Equal(clientcmdapi.NeverExecInteractiveMode))
+	})
+})
+
+var _ = Describe("Full chain Secret", func() {
+	It("puts the super-admin certificate before the CA certificate", func() {
+		leaf := "-----BEGIN CERTIFICATE-----\nleaf\n-----END CERTIFICATE-----"
+		ca := "-----BEGIN CERTIFICATE-----\nca\n-----END CERTIFICATE-----\n"
+		certData := CertificateData{
+			CACert:  base64.StdEncoding.EncodeToString([]byte(ca)),
+			TLSCert: base64.StdEncoding.EncodeToString([]byte(leaf)),
+		}
+
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec:       incloudiov1alpha1.CertificateSetSpec{FullChainSecret: true},
+		}
+
+		secret, err := buildFullChainSecret(cs, certData)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(secret.Name).To(Equal("demo-fullchain"))
+		Expect(secret.Data).To(HaveLen(1))
+		Expect(string(secret.Data["fullchain.pem"])).To(Equal(leaf + "\n" + ca))
+	})
+})
@@ -23,12 +23,20 @@ import (
 	"path/filepath"
 	"testing"
 
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -142,3 +150,47 @@ func envtestBinariesPresent(dir string) bool {
 	}
 	return true
 }
+
+// testScheme registers every type the reconciler reads or writes through the fake client
+var testScheme = func() *runtime.Scheme {
+	s := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(s))
+	utilruntime.Must(certmanagerv1.AddToScheme(s))
+	utilruntime.Must(incloudiov1alpha1.AddToScheme(s))
+	return s
+}()
+
+// testRESTMapper maps every type of testScheme, so that the reconciler sees the cert-manager CRDs as installed
+var testRESTMapper = func() meta.RESTMapper {
+	m := meta.NewDefaultRESTMapper(nil)
+	for gvk := range testScheme.AllKnownTypes() {
+		scope := meta.RESTScopeNamespace
+		if gvk.Kind == "Namespace" || gvk.Kind == certmanagerv1.ClusterIssuerKind {
+			scope = meta.RESTScopeRoot
+		}
+		m.Add(gvk, scope)
+	}
+	return m
+}()
+
+// newTestReconciler returns a reconciler backed by a fake client seeded with objs
+func newTestReconciler(objs ...client.Object) (*CertificateSetReconciler, client.WithWatch) {
+	return newTestReconcilerWithInterceptor(interceptor.Funcs{}, objs...)
+}
+
+// newTestReconcilerWithInterceptor is newTestReconciler with funcs wrapped around the fake client calls
+func newTestReconcilerWithInterceptor(funcs interceptor.Funcs, objs ...client.Object) (*CertificateSetReconciler, client.WithWatch) {
+	c := fake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithRESTMapper(testRESTMapper).
+		WithObjects(objs...).
+		WithStatusSubresource(&incloudiov1alpha1.CertificateSet{}).
+		WithInterceptorFuncs(funcs).
+		Build()
+	return &CertificateSetReconciler{
+		Client:    c,
+		APIReader: c,
+		Scheme:    testScheme,
+		Recorder:  &record.FakeRecorder{},
+	}, c
+}
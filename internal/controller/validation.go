@@ -0,0 +1,102 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+// issuerAPIVersionPattern matches the cert-manager.io versions accepted in issuer references, as in the CRD schema
+var issuerAPIVersionPattern = regexp.MustCompile(`^cert-manager\.io/v[0-9]+((alpha|beta)[0-9]+)?$`)
+
+// maxCertificateSetNameLength keeps ${name}-front-proxy-client, the longest child resource name, within 253 characters
+const maxCertificateSetNameLength = 234
+
+// maxOwnerLabelNameLength is the label value limit: resources outside the CertificateSet namespace carry its name in OwnerNameLabel
+const maxOwnerLabelNameLength = 63
+
+// ValidateCertificateSet checks a CertificateSet without an API server: the CRD rules that depend on the
+// environment, kubeconfigEndpoint, issuer references and the name length, plus the checks the controller runs before creating
+// child resources (labels, endpoint URL, literal subject). All problems are returned joined.
+func ValidateCertificateSet(cs *incloudiov1alpha1.CertificateSet) error {
+	var errs []error
+
+	if len(cs.Name) > maxCertificateSetNameLength {
+		errs = append(errs, fmt.Errorf("metadata.name must be at most %d characters, got %d", maxCertificateSetNameLength, len(cs.Name)))
+	}
+	ownerLabels := cs.Spec.TargetNamespace != "" || len(cs.Spec.KubeconfigMirrorNamespaces) > 0 ||
+		cs.Spec.IssuerScope == incloudiov1alpha1.IssuerScopeClusterIssuer
+	if ownerLabels && len(cs.Name) > maxOwnerLabelNameLength {
+		errs = append(errs, fmt.Errorf("metadata.name must be at most %d characters with targetNamespace, kubeconfigMirrorNamespaces or issuerScope ClusterIssuer, got %d",
+			maxOwnerLabelNameLength, len(cs.Name)))
+	}
+
+	switch cs.Spec.Environment {
+	case incloudiov1alpha1.EnvironmentClient, incloudiov1alpha1.EnvironmentSystem:
+	case incloudiov1alpha1.EnvironmentInfra:
+		if cs.Spec.IssuerRefOidc == nil || cs.Spec.IssuerRefOidc.Name == "" {
+			errs = append(errs, errors.New("spec.issuerRefOidc.name is required for the infra environment"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("spec.environment %q must be one of client, system, infra", cs.Spec.Environment))
+	}
+	if cs.Spec.SelfSignedCA && (cs.Spec.Environment != incloudiov1alpha1.EnvironmentClient || cs.Spec.ExistingCASecretRef != nil) {
+		errs = append(errs, errors.New("spec.selfSignedCA is only supported for the client environment without existingCASecretRef"))
+	}
+	errs = append(errs, validateIssuerReference("spec.issuerRef", &cs.Spec.IssuerRef)...)
+	if cs.Spec.IssuerRefOidc != nil {
+		errs = append(errs, validateIssuerReference("spec.issuerRefOidc", cs.Spec.IssuerRefOidc)...)
+	}
+	if cs.Spec.OIDCCABundleConfigMap != "" && cs.Spec.Environment != incloudiov1alpha1.EnvironmentInfra {
+		errs = append(errs, errors.New("spec.oidcCABundleConfigMap is only supported for the infra environment"))
+	}
+
+	if cs.Spec.KubeconfigEndpoint == "" {
+		if cs.Spec.Kubeconfig || cs.Spec.ArgocdCluster || len(cs.Spec.ClientCertificates) > 0 {
+			errs = append(errs, fmt.Errorf("%w: required by kubeconfig, argocdCluster and clientCertificates", ErrMissingEndpoint))
+		}
+	} else if err := validateKubeconfigEndpoint(cs.Spec.KubeconfigEndpoint); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateChildLabels(cs); err != nil {
+		errs = append(errs, err)
+	}
+	if cs.Spec.LiteralSubject != "" {
+		if err := validateLiteralSubject(cs.Spec.LiteralSubject); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateIssuerReference checks kind and apiVersion of an issuer reference; empty values are defaulted
+func validateIssuerReference(field string, ref *incloudiov1alpha1.IssuerReference) []error {
+	var errs []error
+	if ref.Kind != "" && ref.Kind != "Issuer" && ref.Kind != "ClusterIssuer" {
+		errs = append(errs, fmt.Errorf("%s.kind %q must be exactly Issuer or ClusterIssuer", field, ref.Kind))
+	}
+	if ref.APIVersion != "" && !issuerAPIVersionPattern.MatchString(ref.APIVersion) {
+		errs = append(errs, fmt.Errorf("%s.apiVersion %q must be a cert-manager.io version, e.g. cert-manager.io/v1", field, ref.APIVersion))
+	}
+	return errs
+}
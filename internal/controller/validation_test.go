@@ -0,0 +1,82 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+var _ = Describe("ValidateCertificateSet", func() {
+	It("accepts a client CertificateSet with a kubeconfig endpoint", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:        incloudiov1alpha1.EnvironmentClient,
+				Kubeconfig:         true,
+				KubeconfigEndpoint: "https://demo.example.com:6443",
+			},
+		}
+		Expect(ValidateCertificateSet(cs)).To(Succeed())
+	})
+
+	It("reports every problem", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 235), Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentInfra,
+				Kubeconfig:  true,
+			},
+		}
+		err := ValidateCertificateSet(cs)
+		Expect(err).To(MatchError(ErrMissingEndpoint))
+		Expect(err).To(MatchError(ContainSubstring("metadata.name must be at most 234 characters")))
+		Expect(err).To(MatchError(ContainSubstring("issuerRefOidc.name is required")))
+	})
+
+	It("limits the name to a label value when resources outside the namespace carry owner labels", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 64), Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment: incloudiov1alpha1.EnvironmentSystem,
+			},
+		}
+		Expect(ValidateCertificateSet(cs)).To(Succeed())
+
+		cs.Spec.IssuerScope = incloudiov1alpha1.IssuerScopeClusterIssuer
+		Expect(ValidateCertificateSet(cs)).To(MatchError(ContainSubstring("metadata.name must be at most 63 characters")))
+	})
+
+	It("rejects issuer references that are not cert-manager Issuers or ClusterIssuers", func() {
+		cs := &incloudiov1alpha1.CertificateSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+			Spec: incloudiov1alpha1.CertificateSetSpec{
+				Environment:   incloudiov1alpha1.EnvironmentSystem,
+				IssuerRef:     incloudiov1alpha1.IssuerReference{Kind: "clusterissuer", Name: "root"},
+				IssuerRefOidc: &incloudiov1alpha1.IssuerReference{APIVersion: "example.com/v1", Kind: "Issuer", Name: "oidc"},
+			},
+		}
+		err := ValidateCertificateSet(cs)
+		Expect(err).To(MatchError(ContainSubstring(`spec.issuerRef.kind "clusterissuer" must be exactly Issuer or ClusterIssuer`)))
+		Expect(err).To(MatchError(ContainSubstring(`spec.issuerRefOidc.apiVersion "example.com/v1" must be a cert-manager.io version`)))
+	})
+})
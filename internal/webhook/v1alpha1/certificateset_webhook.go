@@ -0,0 +1,92 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+// log is for logging in this package.
+var certificatesetlog = logf.Log.WithName("certificateset-resource")
+
+// SetupCertificateSetWebhookWithManager registers the webhook for CertificateSet in the manager.
+func SetupCertificateSetWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&incloudiov1alpha1.CertificateSet{}).
+		WithDefaulter(&CertificateSetCustomDefaulter{}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-in-cloud-io-v1alpha1-certificateset,mutating=true,failurePolicy=fail,sideEffects=None,groups=in-cloud.io,resources=certificatesets,verbs=create,versions=v1alpha1,name=mcertificateset-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// CertificateSetCustomDefaulter sets default values on CertificateSets when they are created.
+// Defaults that do not depend on other fields are declared in the CRD schema instead.
+type CertificateSetCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &CertificateSetCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter. spec.kubeconfig defaults to true in the client environment,
+// which exists to hand out a kubeconfig, when spec.kubeconfigEndpoint is set; without an endpoint the
+// kubeconfig could not be rendered, and it stays false, as for system and infra. The field is immutable,
+// so it is only defaulted on CREATE, and a value set explicitly (including false) is kept.
+func (d *CertificateSetCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	cs, ok := obj.(*incloudiov1alpha1.CertificateSet)
+	if !ok {
+		return fmt.Errorf("expected a CertificateSet object but got %T", obj)
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if req.Operation != admissionv1.Create {
+		return nil
+	}
+
+	// The decoded bool cannot tell an omitted kubeconfig from false, so look at the submitted object
+	set, err := kubeconfigSet(req.Object.Raw)
+	if err != nil {
+		return err
+	}
+	if !set && cs.Spec.Environment == incloudiov1alpha1.EnvironmentClient && cs.Spec.KubeconfigEndpoint != "" {
+		certificatesetlog.Info("Defaulting kubeconfig to true", "name", cs.Name, "namespace", cs.Namespace)
+		cs.Spec.Kubeconfig = true
+	}
+	return nil
+}
+
+// kubeconfigSet reports whether the raw CertificateSet has spec.kubeconfig
+func kubeconfigSet(raw []byte) (bool, error) {
+	var obj struct {
+		Spec map[string]json.RawMessage `json:"spec"`
+	}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return false, fmt.Errorf("failed to decode CertificateSet: %w", err)
+	}
+	value, ok := obj.Spec["kubeconfig"]
+	return ok && string(value) != "null", nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	incloudiov1alpha1 "certificate-set/api/v1alpha1"
+)
+
+var _ = Describe("CertificateSet Webhook", func() {
+	// defaultSpec runs the defaulter on a CertificateSet submitted with the given spec
+	defaultSpec := func(operation admissionv1.Operation, spec map[string]any) *incloudiov1alpha1.CertificateSet {
+		raw, err := json.Marshal(map[string]any{"spec": spec})
+		Expect(err).NotTo(HaveOccurred())
+		cs := &incloudiov1alpha1.CertificateSet{}
+		Expect(json.Unmarshal(raw, cs)).To(Succeed())
+
+		ctx := admission.NewContextWithRequest(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: operation,
+			Object:    runtime.RawExtension{Raw: raw},
+		}})
+		Expect((&CertificateSetCustomDefaulter{}).Default(ctx, cs)).To(Succeed())
+		return cs
+	}
+
+	DescribeTable("defaults kubeconfig",
+		func(operation admissionv1.Operation, spec map[string]any, expected bool) {
+			Expect(defaultSpec(operation, spec).Spec.Kubeconfig).To(Equal(expected))
+		},
+		Entry("to true for client on create", admissionv1.Create, map[string]any{"environment": "client", "kubeconfigEndpoint": "https://api.example.com:6443"}, true),
+		Entry("to false for client without an endpoint", admissionv1.Create, map[string]any{"environment": "client"}, false),
+		Entry("keeping an explicit false", admissionv1.Create, map[string]any{"environment": "client", "kubeconfigEndpoint": "https://api.example.com:6443", "kubeconfig": false}, false),
+		Entry("to false for system", admissionv1.Create, map[string]any{"environment": "system"}, false),
+		Entry("to false for infra", admissionv1.Create, map[string]any{"environment": "infra"}, false),
+		Entry("not on update", admissionv1.Update, map[string]any{"environment": "client", "kubeconfigEndpoint": "https://api.example.com:6443"}, false),
+	)
+})